@@ -0,0 +1,319 @@
+package amazontest
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+const maxCartItemQuantity = 999
+
+type cart struct {
+	id        string
+	hmac      string
+	items     []amazon.CartItem
+	saved     []amazon.CartItem
+	sequence  int
+	currency  string
+	associate string
+}
+
+type cartRequestItem struct {
+	asin           string
+	offerListingID string
+	cartItemID     string
+	quantity       *int
+	action         string
+}
+
+func cartRequestItems(values url.Values) []cartRequestItem {
+	var items []cartRequestItem
+	for i := 1; ; i++ {
+		prefix := "Item." + strconv.Itoa(i) + "."
+		item := cartRequestItem{
+			asin:           values.Get(prefix + "ASIN"),
+			offerListingID: values.Get(prefix + "OfferListingId"),
+			cartItemID:     values.Get(prefix + "CartItemId"),
+			action:         values.Get(prefix + "Action"),
+		}
+		if q := values.Get(prefix + "Quantity"); q != "" {
+			n, err := strconv.Atoi(q)
+			if err != nil {
+				n = -1
+			}
+			item.quantity = &n
+		}
+		if item == (cartRequestItem{}) {
+			return items
+		}
+		items = append(items, item)
+	}
+}
+
+func (s *Server) cartHMAC(cartID string) string {
+	mac := hmac.New(sha1.New, []byte(s.SecretAccessKey))
+	mac.Write([]byte(cartID))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Server) findItem(item cartRequestItem) (amazon.Item, bool) {
+	if item.asin != "" {
+		return s.Catalog.Item(item.asin)
+	}
+	for _, catalogItem := range s.Catalog.Items() {
+		for _, offer := range catalogItem.Offers.Offer {
+			if offer.OfferListing.ID == item.offerListingID {
+				return catalogItem, true
+			}
+		}
+	}
+	return amazon.Item{}, false
+}
+
+// addItems adds items to the cart. It must be called with s.mu locked
+func (s *Server) addItems(c *cart, items []cartRequestItem) []amazon.Error {
+	if len(items) == 0 {
+		return []amazon.Error{missingParameter("Items")}
+	}
+	var errs []amazon.Error
+	for _, item := range items {
+		catalogItem, ok := s.findItem(item)
+		if !ok {
+			if item.asin != "" {
+				errs = append(errs, invalidParameterValue(item.asin, "ASIN"))
+			} else {
+				errs = append(errs, invalidParameterValue(item.offerListingID, "OfferListingId"))
+			}
+			continue
+		}
+		quantity := 1
+		if item.quantity != nil {
+			quantity = *item.quantity
+		}
+		if quantity < 1 || quantity > maxCartItemQuantity {
+			errs = append(errs, amazon.Error{
+				Code:    amazon.InvalidQuantity,
+				Message: fmt.Sprintf("The quantity you provided for %v is invalid. Quantity must be between 1 and %d.", catalogItem.ASIN, maxCartItemQuantity),
+			})
+			continue
+		}
+		if findCartItemByASIN(c.items, catalogItem.ASIN) >= 0 || findCartItemByASIN(c.saved, catalogItem.ASIN) >= 0 {
+			errs = append(errs, amazon.Error{
+				Code:    amazon.ItemAlreadyInCart,
+				Message: fmt.Sprintf("The item you specified, %v, is already in your cart.", catalogItem.ASIN),
+			})
+			continue
+		}
+		price := catalogItem.ItemAttributes.ListPrice
+		if c.currency == "" {
+			c.currency = price.CurrencyCode
+		}
+		c.sequence++
+		c.items = append(c.items, amazon.CartItem{
+			ID:           fmt.Sprintf("C%013d", c.sequence),
+			ASIN:         catalogItem.ASIN,
+			Quantity:     quantity,
+			Title:        catalogItem.ItemAttributes.Title,
+			ProductGroup: catalogItem.ItemAttributes.ProductGroup,
+			Price:        price,
+		})
+	}
+	return errs
+}
+
+func findCartItemByASIN(items []amazon.CartItem, asin string) int {
+	for i, item := range items {
+		if item.ASIN == asin {
+			return i
+		}
+	}
+	return -1
+}
+
+func findCartItemByID(items []amazon.CartItem, id string) int {
+	for i, item := range items {
+		if item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func formatPrice(amount int64, currency string) amazon.Price {
+	price := amazon.NewPrice(amazon.NewMoney(amount, amazon.Currency(currency)))
+	price.FormattedPrice = fmt.Sprintf("%v %d", currency, amount)
+	return price
+}
+
+func subTotal(items []amazon.CartItem, currency string) (amazon.Price, []amazon.CartItem) {
+	total := amazon.NewMoney(0, amazon.Currency(currency))
+	priced := make([]amazon.CartItem, len(items))
+	for i, item := range items {
+		price, _ := amazon.ParseMoney(item.Price.Amount, item.Price.CurrencyCode)
+		itemTotal := price.Mul(int64(item.Quantity))
+		item.ItemTotal = formatPrice(itemTotal.Amount, item.Price.CurrencyCode)
+		if sum, err := total.Add(itemTotal); err == nil {
+			total = sum
+		}
+		priced[i] = item
+	}
+	return formatPrice(total.Amount, currency), priced
+}
+
+// cartXML returns amazon.Cart of the cart. It must be called with s.mu locked
+func (s *Server) cartXML(c *cart, errs []amazon.Error) amazon.Cart {
+	res := amazon.Cart{Request: validRequest(errs...)}
+	if c == nil {
+		return res
+	}
+	res.ID = c.id
+	res.HMAC = c.hmac
+	res.URLEncodedHMAC = url.QueryEscape(c.hmac)
+	query := "cart-id=" + c.id + "&associate-id=" + url.QueryEscape(c.associate) + "&hmac=" + url.QueryEscape(c.hmac) + "&SubscriptionId=" + url.QueryEscape(s.AccessKeyID)
+	res.PurchaseURL = s.URL + "/gp/cart/aws-merge.html?" + query + "&MergeCart=False"
+	res.MobileCartURL = s.URL + "/gp/aw/rcart?" + query + "&MergeCart=False"
+	res.CartItems.SubTotal, res.CartItems.CartItem = subTotal(c.items, c.currency)
+	res.SubTotal = res.CartItems.SubTotal
+	var saved []amazon.CartItem
+	res.SavedForLaterItems.SubTotal, saved = subTotal(c.saved, c.currency)
+	for _, item := range saved {
+		res.SavedForLaterItems.SavedForLaterItem = append(res.SavedForLaterItems.SavedForLaterItem, amazon.SavedForLaterItem{CartItem: item})
+	}
+	return res
+}
+
+// findCart returns the cart validating CartId and HMAC. It must be called with s.mu locked
+func (s *Server) findCart(values url.Values) (*cart, []amazon.Error) {
+	id := values.Get("CartId")
+	if id == "" {
+		return nil, []amazon.Error{missingParameter("CartId")}
+	}
+	h := values.Get("HMAC")
+	if h == "" {
+		return nil, []amazon.Error{missingParameter("HMAC")}
+	}
+	c, ok := s.carts[id]
+	if !ok {
+		return nil, []amazon.Error{{
+			Code:    amazon.InvalidCartID,
+			Message: "Your request contains an invalid value for CartId. Please check your CartId and retry your request.",
+		}}
+	}
+	if !hmac.Equal([]byte(h), []byte(c.hmac)) {
+		return nil, []amazon.Error{{
+			Code:    amazon.InvalidHMAC,
+			Message: "Your request contains an invalid value for HMAC. Please check your HMAC and retry your request.",
+		}}
+	}
+	return c, nil
+}
+
+func (s *Server) cartCreate(values url.Values) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sequence++
+	id := fmt.Sprintf("%03d-%07d-%07d", s.sequence%1000, s.sequence, len(s.carts))
+	c := &cart{id: id, hmac: s.cartHMAC(id), associate: values.Get("AssociateTag")}
+	errs := s.addItems(c, cartRequestItems(values))
+	if len(c.items) == 0 {
+		return amazon.CartCreateResponse{Cart: s.cartXML(nil, errs)}
+	}
+	s.carts[id] = c
+	return amazon.CartCreateResponse{Cart: s.cartXML(c, errs)}
+}
+
+func (s *Server) cartAdd(values url.Values) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, errs := s.findCart(values)
+	if c != nil {
+		errs = s.addItems(c, cartRequestItems(values))
+	}
+	return amazon.CartAddResponse{Cart: s.cartXML(c, errs)}
+}
+
+func (s *Server) cartModify(values url.Values) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, errs := s.findCart(values)
+	if c == nil {
+		return amazon.CartModifyResponse{Cart: s.cartXML(c, errs)}
+	}
+	items := cartRequestItems(values)
+	if len(items) == 0 {
+		errs = append(errs, missingParameter("Items"))
+	}
+	for _, item := range items {
+		if item.cartItemID == "" {
+			errs = append(errs, missingParameter("CartItemId"))
+			continue
+		}
+		if item.quantity == nil && item.action == "" {
+			errs = append(errs, amazon.Error{
+				Code:    amazon.ExactParameterRequirement,
+				Message: "Your request should have exactly one of the following parameters: Quantity, Action.",
+			})
+			continue
+		}
+		if item.quantity != nil && (*item.quantity < 0 || *item.quantity > maxCartItemQuantity) {
+			errs = append(errs, amazon.Error{
+				Code:    amazon.InvalidQuantity,
+				Message: fmt.Sprintf("The quantity you provided for %v is invalid. Quantity must be between 0 and %d.", item.cartItemID, maxCartItemQuantity),
+			})
+			continue
+		}
+		i, j := findCartItemByID(c.items, item.cartItemID), findCartItemByID(c.saved, item.cartItemID)
+		if i < 0 && j < 0 {
+			errs = append(errs, invalidParameterValue(item.cartItemID, "CartItemId"))
+			continue
+		}
+		switch amazon.CartModifyAction(item.action) {
+		case amazon.CartModifyActionSaveForLater:
+			if i >= 0 {
+				c.saved = append(c.saved, c.items[i])
+				c.items = append(c.items[:i], c.items[i+1:]...)
+			}
+		case amazon.CartModifyActionMoveToCart:
+			if j >= 0 {
+				c.items = append(c.items, c.saved[j])
+				c.saved = append(c.saved[:j], c.saved[j+1:]...)
+			}
+		}
+		if item.quantity != nil {
+			list := &c.items
+			if i < 0 {
+				list = &c.saved
+				i = j
+			}
+			if *item.quantity == 0 {
+				*list = append((*list)[:i], (*list)[i+1:]...)
+			} else {
+				(*list)[i].Quantity = *item.quantity
+			}
+		}
+	}
+	return amazon.CartModifyResponse{Cart: s.cartXML(c, errs)}
+}
+
+func (s *Server) cartGet(values url.Values) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, errs := s.findCart(values)
+	return amazon.CartGetResponse{Cart: s.cartXML(c, errs)}
+}
+
+func (s *Server) cartClear(values url.Values) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, errs := s.findCart(values)
+	if c != nil {
+		c.items = nil
+		c.saved = nil
+	}
+	return amazon.CartClearResponse{Cart: s.cartXML(c, errs)}
+}
@@ -0,0 +1,133 @@
+package amazontest
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+// Catalog is in-memory catalog of items and browse nodes served by Server.
+// It is safe for concurrent use
+type Catalog struct {
+	mu          sync.RWMutex
+	items       map[string]amazon.Item
+	asins       []string
+	browseNodes map[string]amazon.BrowseNode
+}
+
+// NewCatalog returns empty Catalog
+func NewCatalog() *Catalog {
+	return &Catalog{
+		items:       map[string]amazon.Item{},
+		browseNodes: map[string]amazon.BrowseNode{},
+	}
+}
+
+// AddItem adds or replaces items keyed by ASIN.
+// Items are returned by ItemSearch in the order they are added first
+func (catalog *Catalog) AddItem(items ...amazon.Item) {
+	catalog.mu.Lock()
+	defer catalog.mu.Unlock()
+	for _, item := range items {
+		if _, ok := catalog.items[item.ASIN]; !ok {
+			catalog.asins = append(catalog.asins, item.ASIN)
+		}
+		catalog.items[item.ASIN] = item
+	}
+}
+
+// AddBrowseNode adds or replaces browse nodes keyed by ID
+func (catalog *Catalog) AddBrowseNode(nodes ...amazon.BrowseNode) {
+	catalog.mu.Lock()
+	defer catalog.mu.Unlock()
+	for _, node := range nodes {
+		catalog.browseNodes[node.ID] = node
+	}
+}
+
+// Item returns item with the ASIN
+func (catalog *Catalog) Item(asin string) (amazon.Item, bool) {
+	catalog.mu.RLock()
+	defer catalog.mu.RUnlock()
+	item, ok := catalog.items[asin]
+	return item, ok
+}
+
+// BrowseNode returns browse node with the ID
+func (catalog *Catalog) BrowseNode(id string) (amazon.BrowseNode, bool) {
+	catalog.mu.RLock()
+	defer catalog.mu.RUnlock()
+	node, ok := catalog.browseNodes[id]
+	return node, ok
+}
+
+// Items returns all items in the order they are added
+func (catalog *Catalog) Items() []amazon.Item {
+	catalog.mu.RLock()
+	defer catalog.mu.RUnlock()
+	items := make([]amazon.Item, len(catalog.asins))
+	for i, asin := range catalog.asins {
+		items[i] = catalog.items[asin]
+	}
+	return items
+}
+
+type searchFilter struct {
+	keywords   []string
+	title      string
+	browseNode string
+	minPrice   int64
+	maxPrice   int64
+	// hasMaxPrice is set if MaximumPrice is specified, as zero is a valid upper bound
+	hasMaxPrice bool
+}
+
+func (filter searchFilter) match(item amazon.Item) bool {
+	attrs := item.ItemAttributes
+	if filter.title != "" && !containsFold(attrs.Title, filter.title) {
+		return false
+	}
+	text := strings.Join(append([]string{attrs.Title, attrs.Manufacturer, attrs.Publisher}, attrs.Author...), " ")
+	for _, keyword := range filter.keywords {
+		if !containsFold(text, keyword) {
+			return false
+		}
+	}
+	if filter.browseNode != "" && !hasBrowseNode(item.BrowseNodes, filter.browseNode) {
+		return false
+	}
+	if filter.minPrice > 0 || filter.hasMaxPrice {
+		price, err := amazon.ParseMoney(attrs.ListPrice.Amount, attrs.ListPrice.CurrencyCode)
+		if err != nil {
+			return false
+		}
+		if price.Amount < filter.minPrice || filter.hasMaxPrice && price.Amount > filter.maxPrice {
+			return false
+		}
+	}
+	return true
+}
+
+func (catalog *Catalog) search(filter searchFilter) []amazon.Item {
+	var found []amazon.Item
+	for _, item := range catalog.Items() {
+		if filter.match(item) {
+			found = append(found, item)
+		}
+	}
+	return found
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func hasBrowseNode(nodes amazon.BrowseNodes, id string) bool {
+	for _, node := range nodes.BrowseNode {
+		if node.ID == id || hasBrowseNode(node.Ancestors, id) {
+			return true
+		}
+	}
+	return false
+}
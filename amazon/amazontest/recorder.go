@@ -0,0 +1,269 @@
+package amazontest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/signer"
+)
+
+// RecorderMode is mode of Recorder
+type RecorderMode int
+
+const (
+	// ModeReplay serves recorded responses only and fails on requests not recorded
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests with Transport and records the responses
+	ModeRecord
+)
+
+// CassetteFile is name of the file in Recorder.Dir indexing recorded responses
+const CassetteFile = "cassette.xml"
+
+// ignoredQueryKeys are excluded from keys of recorded responses as they vary by credentials or time
+var ignoredQueryKeys = []string{"Signature", "Timestamp", "AWSAccessKeyId", "AssociateTag"}
+
+// Recorder is http.RoundTripper that records API responses to Dir and replays them.
+// Responses are keyed by method, host, path and query of the request excluding
+// Signature, Timestamp, AWSAccessKeyId and AssociateTag, so that replay works with any credentials.
+//
+// Each response body is written as is to <Operation>-<hash>.xml, in the same layout as _fixtures,
+// and CassetteFile maps the keys to the files with status codes.
+//
+// Clients are rate limited by amazon.DefaultRateLimiter even when replaying.
+// Pass amazon.WithRateLimiter(nil) to replay without waiting, but keep the limiter while recording.
+//
+//	recorder, _ := amazontest.NewRecorder("testdata/cassette", amazontest.ModeReplay)
+//	client, _ := amazon.New("AK", "SK", "ngsio-22", amazon.RegionJapan,
+//		amazon.WithTransport(recorder), amazon.WithRateLimiter(nil))
+type Recorder struct {
+	// Dir is directory storing the cassette
+	Dir string
+	// Mode is ModeReplay or ModeRecord
+	Mode RecorderMode
+	// Transport sends requests in ModeRecord. http.DefaultTransport is used if nil
+	Transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions map[string]Interaction
+}
+
+// Cassette is the index of recorded responses stored in CassetteFile
+type Cassette struct {
+	XMLName      xml.Name      `xml:"Cassette"`
+	Interactions []Interaction `xml:"Interaction"`
+}
+
+// Interaction is recorded pair of request key and response
+type Interaction struct {
+	Key         string `xml:"Request"`
+	StatusCode  int    `xml:"Response>StatusCode"`
+	ContentType string `xml:"Response>ContentType,omitempty"`
+	File        string `xml:"Response>File"`
+}
+
+// NewRecorder returns Recorder loading the cassette in dir.
+// The cassette must exist in ModeReplay
+func NewRecorder(dir string, mode RecorderMode) (*Recorder, error) {
+	recorder := &Recorder{Dir: dir, Mode: mode, interactions: map[string]Interaction{}}
+	data, err := ioutil.ReadFile(filepath.Join(dir, CassetteFile))
+	if os.IsNotExist(err) && mode == ModeRecord {
+		return recorder, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := xml.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("Invalid cassette %v: %v", filepath.Join(dir, CassetteFile), err)
+	}
+	for _, interaction := range cassette.Interactions {
+		recorder.interactions[interaction.Key] = interaction
+	}
+	return recorder, nil
+}
+
+// RoundTrip replays or records the response for the request.
+// In ModeReplay, it returns error for requests not recorded instead of sending them
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, err := rewindable(req)
+	if err != nil {
+		return nil, err
+	}
+	key, err := RequestKey(req)
+	if err != nil {
+		return nil, err
+	}
+	if recorder.Mode == ModeRecord {
+		return recorder.record(req, key)
+	}
+	recorder.mu.Lock()
+	interaction, ok := recorder.interactions[key]
+	recorder.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("No recorded response in %v for %v", recorder.Dir, key)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(recorder.Dir, interaction.File))
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{}
+	if interaction.ContentType != "" {
+		header.Set("Content-Type", interaction.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}, nil
+}
+
+func (recorder *Recorder) record(req *http.Request, key string) (*http.Response, error) {
+	transport := recorder.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(data))
+	values, _ := requestValues(req)
+	sum := sha256.Sum256([]byte(key))
+	interaction := Interaction{
+		Key:         key,
+		StatusCode:  res.StatusCode,
+		ContentType: res.Header.Get("Content-Type"),
+		File:        values.Get("Operation") + "-" + hex.EncodeToString(sum[:6]) + ".xml",
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if err := os.MkdirAll(recorder.Dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(recorder.Dir, interaction.File), data, 0644); err != nil {
+		return nil, err
+	}
+	if recorder.interactions == nil {
+		recorder.interactions = map[string]Interaction{}
+	}
+	recorder.interactions[key] = interaction
+	if err := recorder.save(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// save writes the cassette. It must be called with recorder.mu locked
+func (recorder *Recorder) save() error {
+	cassette := Cassette{}
+	for _, interaction := range recorder.interactions {
+		cassette.Interactions = append(cassette.Interactions, interaction)
+	}
+	sort.Slice(cassette.Interactions, func(i, j int) bool {
+		return cassette.Interactions[i].Key < cassette.Interactions[j].Key
+	})
+	data, err := xml.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append([]byte(xml.Header), append(data, '\n')...)
+	return ioutil.WriteFile(filepath.Join(recorder.Dir, CassetteFile), data, 0644)
+}
+
+// RequestKey returns key of the request used by Recorder.
+// It consists of method, host, path and sorted query excluding Signature, Timestamp, AWSAccessKeyId and AssociateTag.
+// Form values of POST request are read with GetBody, leaving the body unread
+func RequestKey(req *http.Request) (string, error) {
+	values, err := requestValues(req)
+	if err != nil {
+		return "", err
+	}
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	return req.Method + " " + host + req.URL.EscapedPath() + "?" + canonicalQuery(values, ignoredQueryKeys...), nil
+}
+
+// rewindable returns the request if its body can be read again with GetBody,
+// or its clone with the body buffered otherwise, without replacing the body of the request
+func rewindable(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return req, nil
+	}
+	data, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = ioutil.NopCloser(bytes.NewReader(data))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+	return clone, nil
+}
+
+// requestValues returns query values of GET request or form values of POST request read with GetBody
+func requestValues(req *http.Request) (url.Values, error) {
+	if req.Method != "POST" || req.Body == nil || req.Body == http.NoBody {
+		return req.URL.Query(), nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("GetBody is not specified")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	return url.ParseQuery(string(data))
+}
+
+// canonicalQuery returns query sorted by keys and escaped as signed by the API, excluding keys in ignore
+func canonicalQuery(values url.Values, ignore ...string) string {
+	filtered := url.Values{}
+	for key, value := range values {
+		if !containsString(ignore, key) {
+			filtered[key] = value
+		}
+	}
+	return signer.CanonicalQueryString(filtered)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package amazontest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazontest-recorder")
+	defer os.RemoveAll(dir)
+	server := newTestServer()
+	recorder, err := NewRecorder(dir, ModeRecord)
+	Test{nil, err}.Compare(t)
+	recorder.Transport = server.Transport()
+	client, _ := amazon.New("AK", "SK", "ngsio-22", amazon.RegionJapan, amazon.WithTransport(recorder), amazon.WithRateLimiter(nil))
+	res, err := client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+	Test{nil, err}.Compare(t)
+	Test{"Rust Book", res.Items.Item[0].ItemAttributes.Title}.Compare(t)
+	_, err = client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"UNKNOWN"}}).Do()
+	Test{true, err != nil}.Compare(t)
+	items := amazon.CartRequestItems{}
+	items.AddASIN("4621300253", 1)
+	_, err = client.CartCreate(amazon.CartCreateParameters{Items: items}).Do()
+	Test{nil, err}.Compare(t)
+	server.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "ItemLookup-*.xml"))
+	Test{2, len(files)}.Compare(t)
+	data, _ := ioutil.ReadFile(files[0])
+	Test{true, strings.Contains(string(data), "<ItemLookupResponse>")}.Compare(t)
+	data, _ = ioutil.ReadFile(filepath.Join(dir, CassetteFile))
+	Test{true, strings.Contains(string(data), "<Request>GET webservices.amazon.co.jp/onca/xml?Item.1.ASIN=4621300253&amp;Item.1.Quantity=1&amp;Operation=CartCreate&amp;ResponseGroup=&amp;Service=AWSECommerceService&amp;Version=2013-08-01</Request>")}.Compare(t)
+
+	recorder, err = NewRecorder(dir, ModeReplay)
+	Test{nil, err}.Compare(t)
+	client, _ = amazon.New("AK2", "SK2", "other-22", amazon.RegionJapan, amazon.WithTransport(recorder), amazon.WithRateLimiter(nil))
+	res, err = client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+	Test{nil, err}.Compare(t)
+	Test{"Rust Book", res.Items.Item[0].ItemAttributes.Title}.Compare(t)
+	_, err = client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"UNKNOWN"}}).Do()
+	Test{true, err != nil && strings.Contains(err.Error(), "UNKNOWN is not a valid value for ItemId")}.Compare(t)
+	cart, err := client.CartCreate(amazon.CartCreateParameters{Items: items}).Do()
+	Test{nil, err}.Compare(t)
+	Test{1, len(cart.Cart.CartItems.CartItem)}.Compare(t)
+
+	_, err = client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"B000000001"}}).Do()
+	Test{true, err != nil && strings.Contains(err.Error(), "No recorded response in "+dir+" for GET webservices.amazon.co.jp/onca/xml?IdType=&ItemId=B000000001&Operation=ItemLookup&ResponseGroup=&Service=AWSECommerceService&Version=2013-08-01")}.Compare(t)
+}
+
+func TestRecorderReplayWithoutCassette(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazontest-recorder")
+	defer os.RemoveAll(dir)
+	_, err := NewRecorder(dir, ModeReplay)
+	Test{true, os.IsNotExist(err)}.Compare(t)
+	recorder, err := NewRecorder(dir, ModeRecord)
+	Test{nil, err}.Compare(t)
+	Test{ModeRecord, recorder.Mode}.Compare(t)
+}
+
+func TestRequestKey(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://webservices.amazon.co.jp/onca/xml?Timestamp=2016&Signature=abc&AWSAccessKeyId=AK&AssociateTag=ngsio-22&Operation=ItemSearch&Keywords=Go+Lang", nil)
+	key, err := RequestKey(req)
+	Test{nil, err}.Compare(t)
+	Test{"GET webservices.amazon.co.jp/onca/xml?Keywords=Go%20Lang&Operation=ItemSearch", key}.Compare(t)
+
+	req, _ = http.NewRequest("POST", "https://webservices.amazon.co.jp/onca/xml", strings.NewReader("Timestamp=2016&Operation=CartGet&CartId=1"))
+	key, _ = RequestKey(req)
+	Test{"POST webservices.amazon.co.jp/onca/xml?CartId=1&Operation=CartGet", key}.Compare(t)
+	body, _ := ioutil.ReadAll(req.Body)
+	Test{"Timestamp=2016&Operation=CartGet&CartId=1", string(body)}.Compare(t)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRecorderRequestBody(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazontest-recorder")
+	defer os.RemoveAll(dir)
+	recorder, _ := NewRecorder(dir, ModeRecord)
+	var sent []string
+	recorder.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		data, _ := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		sent = append(sent, string(data))
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader("<CartGetResponse></CartGetResponse>")),
+			Request:    req,
+		}, nil
+	})
+	const form = "Operation=CartGet&CartId=1"
+	req, _ := http.NewRequest("POST", "https://webservices.amazon.co.jp/onca/xml", strings.NewReader(form))
+	body := req.Body
+	_, err := recorder.RoundTrip(req)
+	Test{nil, err}.Compare(t)
+	Test{body, req.Body}.Compare(t)
+
+	req, _ = http.NewRequest("POST", "https://webservices.amazon.co.jp/onca/xml", strings.NewReader("Operation=CartGet&CartId=2"))
+	req.GetBody = nil
+	body = req.Body
+	_, err = recorder.RoundTrip(req)
+	Test{nil, err}.Compare(t)
+	Test{body, req.Body}.Compare(t)
+	Test{true, req.GetBody == nil}.Compare(t)
+	Test{[]string{form, "Operation=CartGet&CartId=2"}, sent}.DeepEqual(t)
+
+	recorder, _ = NewRecorder(dir, ModeReplay)
+	req, _ = http.NewRequest("POST", "https://webservices.amazon.co.jp/onca/xml", strings.NewReader(form))
+	body = req.Body
+	res, err := recorder.RoundTrip(req)
+	Test{nil, err}.Compare(t)
+	Test{body, req.Body}.Compare(t)
+	Test{200, res.StatusCode}.Compare(t)
+}
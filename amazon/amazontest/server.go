@@ -0,0 +1,356 @@
+// Package amazontest provides fake Product Advertising API server for testing.
+//
+// Server verifies signatures of requests with the given credentials, serves ItemSearch, ItemLookup,
+// SimilarityLookup and BrowseNodeLookup from in-memory Catalog, and keeps carts for Cart operations.
+//
+//	server := amazontest.NewServer("AK", "SK")
+//	defer server.Close()
+//	server.Catalog.AddItem(amazon.Item{ASIN: "4621300253"})
+//	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+//	res, err := client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+package amazontest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/signer"
+)
+
+const (
+	// SignatureDoesNotMatch is error code returned when the signature is invalid
+	SignatureDoesNotMatch amazon.ErrorCode = "SignatureDoesNotMatch"
+	// InvalidClientTokenID is error code returned when AWSAccessKeyId is unknown
+	InvalidClientTokenID amazon.ErrorCode = "InvalidClientTokenId"
+)
+
+// Server is fake Product Advertising API server
+type Server struct {
+	*httptest.Server
+	AccessKeyID     string
+	SecretAccessKey string
+	Catalog         *Catalog
+
+	mu        sync.Mutex
+	carts     map[string]*cart
+	sequence  int
+	requests  int
+	responses map[string]func(url.Values) interface{}
+}
+
+// NewServer starts and returns new Server accepting requests signed with the credentials
+func NewServer(accessKeyID string, secretAccessKey string) *Server {
+	s := NewUnstartedServer(accessKeyID, secretAccessKey)
+	s.Start()
+	return s
+}
+
+// NewUnstartedServer returns new Server without starting it
+func NewUnstartedServer(accessKeyID string, secretAccessKey string) *Server {
+	s := &Server{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		Catalog:         NewCatalog(),
+		carts:           map[string]*cart{},
+	}
+	s.responses = map[string]func(url.Values) interface{}{
+		"ItemSearch":       s.itemSearch,
+		"ItemLookup":       s.itemLookup,
+		"SimilarityLookup": s.similarityLookup,
+		"BrowseNodeLookup": s.browseNodeLookup,
+		"CartCreate":       s.cartCreate,
+		"CartAdd":          s.cartAdd,
+		"CartModify":       s.cartModify,
+		"CartGet":          s.cartGet,
+		"CartClear":        s.cartClear,
+	}
+	s.Server = httptest.NewUnstartedServer(s)
+	return s
+}
+
+// Requests returns number of requests the server has received
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Transport returns http.RoundTripper that sends all requests to the server, keeping the original Host
+func (s *Server) Transport() http.RoundTripper {
+	target, _ := url.Parse(s.URL)
+	return &rewriteTransport{target: target, base: s.Client().Transport}
+}
+
+// NewClient returns amazon.Client sending requests to the server with the credentials of the server.
+// The endpoint of the client is overridden with the URL of the server, and requests are not rate limited
+func (s *Server) NewClient(associateTag string, region amazon.Region, options ...amazon.Option) (*amazon.Client, error) {
+	options = append([]amazon.Option{
+		amazon.WithEndpoint(s.URL + "/onca/xml"),
+		amazon.WithHTTPClient(s.Client()),
+		amazon.WithRateLimiter(nil),
+	}, options...)
+	return amazon.New(s.AccessKeyID, s.SecretAccessKey, associateTag, region, options...)
+}
+
+type rewriteTransport struct {
+	target *url.URL
+	base   http.RoundTripper
+}
+
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.Host = req.URL.Host
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	return t.base.RoundTrip(r)
+}
+
+type errorResponse struct {
+	XMLName   xml.Name
+	Error     amazon.Error `xml:"Error"`
+	RequestID string       `xml:"RequestId"`
+}
+
+// ServeHTTP handles the API request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	requestID := fmt.Sprintf("%08x-0000-4000-8000-%012x", s.requests, s.requests)
+	s.mu.Unlock()
+	var values url.Values
+	switch r.Method {
+	case "GET":
+		values = r.URL.Query()
+	case "POST":
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		values = r.PostForm
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	op := values.Get("Operation")
+	respond, ok := s.responses[op]
+	if !ok {
+		s.writeError(w, http.StatusBadRequest, op, requestID, amazon.InvalidOperationParameter,
+			fmt.Sprintf("The Operation parameter is invalid. Please modify the Operation parameter and retry. Valid values for the Operation parameter include %v.", strings.Join(s.operations(), ", ")))
+		return
+	}
+	if values.Get("AWSAccessKeyId") != s.AccessKeyID {
+		s.writeError(w, http.StatusForbidden, op, requestID, InvalidClientTokenID,
+			"The AWS Access Key Id you provided does not exist in our records.")
+		return
+	}
+	if values.Get("Timestamp") == "" {
+		s.writeError(w, http.StatusBadRequest, op, requestID, amazon.MissingParameters,
+			"Your request is missing required parameters. Required parameters include Timestamp.")
+		return
+	}
+	if !hmac.Equal([]byte(values.Get("Signature")), []byte(s.signature(r.Method, r.Host, r.URL.Path, values))) {
+		s.writeError(w, http.StatusForbidden, op, requestID, SignatureDoesNotMatch,
+			"The request signature we calculated does not match the signature you provided. Check your AWS Secret Access Key and signing method. Consult the service documentation for details.")
+		return
+	}
+	s.writeXML(w, http.StatusOK, respond(values))
+}
+
+func (s *Server) operations() []string {
+	ops := make([]string, 0, len(s.responses))
+	for op := range s.responses {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+func (s *Server) signature(method, host, path string, values url.Values) string {
+	msg := signer.QueryStringToSign(method, host, path, values)
+	mac := hmac.New(sha256.New, []byte(s.SecretAccessKey))
+	mac.Write([]byte(msg))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, op, requestID string, code amazon.ErrorCode, message string) {
+	if op == "" {
+		op = "ItemSearch"
+	}
+	s.writeXML(w, status, errorResponse{
+		XMLName:   xml.Name{Local: op + "ErrorResponse"},
+		Error:     amazon.Error{Code: code, Message: message},
+		RequestID: requestID,
+	})
+}
+
+func (s *Server) writeXML(w http.ResponseWriter, status int, v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header))
+	w.Write(data)
+}
+
+func validRequest(errs ...amazon.Error) amazon.Request {
+	req := amazon.Request{IsValid: true}
+	if len(errs) > 0 {
+		req.Errors = &amazon.Errors{ErrorNode: errs}
+	}
+	return req
+}
+
+func missingParameter(name string) amazon.Error {
+	return amazon.Error{
+		Code:    amazon.MissingParameters,
+		Message: fmt.Sprintf("Your request is missing required parameters. Required parameters include %v.", name),
+	}
+}
+
+func invalidParameterValue(value, name string) amazon.Error {
+	return amazon.Error{
+		Code:    amazon.InvalidParameterValue,
+		Message: fmt.Sprintf("%v is not a valid value for %v. Please change this value and retry your request.", value, name),
+	}
+}
+
+func splitValues(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func (s *Server) itemSearch(values url.Values) interface{} {
+	res := amazon.ItemSearchResponse{}
+	index := amazon.SearchIndex(values.Get("SearchIndex"))
+	if index == "" {
+		res.Items.Request = validRequest(missingParameter("SearchIndex"))
+		return res
+	}
+	page := 1
+	if v := values.Get("ItemPage"); v != "" {
+		page, _ = strconv.Atoi(v)
+	}
+	if page < 1 || page > index.MaxItemPage() {
+		res.Items.Request = validRequest(amazon.Error{
+			Code:    amazon.ParameterOutOfRange,
+			Message: fmt.Sprintf("The value you specified for ItemPage is invalid. Valid values must be between 1 and %d.", index.MaxItemPage()),
+		})
+		return res
+	}
+	filter := searchFilter{
+		keywords:   strings.Fields(values.Get("Keywords")),
+		title:      values.Get("Title"),
+		browseNode: values.Get("BrowseNode"),
+	}
+	filter.minPrice, _ = strconv.ParseInt(values.Get("MinimumPrice"), 10, 64)
+	if maxPrice := values.Get("MaximumPrice"); maxPrice != "" {
+		filter.maxPrice, _ = strconv.ParseInt(maxPrice, 10, 64)
+		filter.hasMaxPrice = true
+	}
+	found := s.Catalog.search(filter)
+	res.Items.Request = validRequest()
+	res.Items.TotalResults = len(found)
+	res.Items.TotalPages = (len(found) + 9) / 10
+	for i := (page - 1) * 10; i < page*10 && i < len(found); i++ {
+		res.Items.Item = append(res.Items.Item, found[i])
+	}
+	if len(found) == 0 {
+		res.Items.Request = validRequest(amazon.Error{
+			Code:    amazon.NoExactMatches,
+			Message: "We did not find any matches for your request.",
+		})
+	}
+	return res
+}
+
+func (s *Server) itemLookup(values url.Values) interface{} {
+	res := amazon.ItemLookupResponse{}
+	res.Items.Item, res.Items.Request = s.lookupItems(values.Get("ItemId"), "ItemId")
+	return res
+}
+
+func (s *Server) lookupItems(ids string, param string) ([]amazon.Item, amazon.Request) {
+	itemIDs := splitValues(ids)
+	if len(itemIDs) == 0 {
+		return nil, validRequest(missingParameter(param))
+	}
+	if len(itemIDs) > amazon.MaxItemLookupItemIDs {
+		return nil, validRequest(amazon.Error{
+			Code:    amazon.ExceededMaximumParameterValues,
+			Message: fmt.Sprintf("Your request contains more than the maximum number of values allowed for the %v parameter. The maximum number of values allowed is %d.", param, amazon.MaxItemLookupItemIDs),
+		})
+	}
+	var items []amazon.Item
+	var errs []amazon.Error
+	for _, id := range itemIDs {
+		if item, ok := s.Catalog.Item(id); ok {
+			items = append(items, item)
+		} else {
+			errs = append(errs, invalidParameterValue(id, param))
+		}
+	}
+	return items, validRequest(errs...)
+}
+
+func (s *Server) similarityLookup(values url.Values) interface{} {
+	res := amazon.SimilarityLookupResponse{}
+	items, req := s.lookupItems(values.Get("ItemId"), "ItemId")
+	res.Items.Request = req
+	if req.Errors != nil {
+		return res
+	}
+	seen := map[string]bool{}
+	for _, item := range items {
+		seen[item.ASIN] = true
+	}
+	for _, item := range items {
+		for _, similar := range item.SimilarProducts.SimilarProduct {
+			if similarItem, ok := s.Catalog.Item(similar.ASIN); ok && !seen[similar.ASIN] {
+				seen[similar.ASIN] = true
+				res.Items.Item = append(res.Items.Item, similarItem)
+			}
+		}
+	}
+	if len(res.Items.Item) == 0 {
+		res.Items.Request = validRequest(amazon.Error{
+			Code:    amazon.NoSimilarities,
+			Message: "There are no similar items for this ASIN.",
+		})
+	}
+	return res
+}
+
+func (s *Server) browseNodeLookup(values url.Values) interface{} {
+	res := amazon.BrowseNodeLookupResponse{}
+	ids := splitValues(values.Get("BrowseNodeId"))
+	if len(ids) == 0 {
+		res.Results.Request = validRequest(missingParameter("BrowseNodeId"))
+		return res
+	}
+	var errs []amazon.Error
+	for _, id := range ids {
+		if node, ok := s.Catalog.BrowseNode(id); ok {
+			res.Results.BrowseNode = append(res.Results.BrowseNode, node)
+		} else {
+			errs = append(errs, invalidParameterValue(id, "BrowseNodeId"))
+		}
+	}
+	res.Results.Request = validRequest(errs...)
+	return res
+}
@@ -0,0 +1,233 @@
+package amazontest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+type Test struct {
+	expected interface{}
+	actual   interface{}
+}
+
+func (test Test) Compare(t *testing.T) {
+	if test.expected != test.actual {
+		_, file, line, _ := runtime.Caller(1)
+		t.Errorf("%v:%v Expected %v but got %v", file, line, test.expected, test.actual)
+	}
+}
+
+func (test Test) DeepEqual(t *testing.T) {
+	if !reflect.DeepEqual(test.expected, test.actual) {
+		_, file, line, _ := runtime.Caller(1)
+		t.Errorf("%v:%v Expected %v but got %v", file, line, test.expected, test.actual)
+	}
+}
+
+func newTestItem(asin, title string, price int) amazon.Item {
+	item := amazon.Item{ASIN: asin}
+	item.ItemAttributes.Title = title
+	item.ItemAttributes.ListPrice = amazon.Price{
+		Amount:         fmt.Sprint(price),
+		CurrencyCode:   "JPY",
+		FormattedPrice: fmt.Sprintf("￥ %d", price),
+	}
+	return item
+}
+
+func newTestServer() *Server {
+	server := NewServer("AK", "SK")
+	for i := 1; i <= 25; i++ {
+		item := newTestItem(fmt.Sprintf("B%09d", i), fmt.Sprintf("Go Book %d", i), i*100)
+		item.BrowseNodes.BrowseNode = []amazon.BrowseNode{{ID: "2", Ancestors: amazon.BrowseNodes{BrowseNode: []amazon.BrowseNode{{ID: "1"}}}}}
+		server.Catalog.AddItem(item)
+	}
+	similar := amazon.SimilarProduct{}
+	similar.ASIN = "B000000002"
+	item, _ := server.Catalog.Item("B000000001")
+	item.SimilarProducts.SimilarProduct = []amazon.SimilarProduct{similar}
+	server.Catalog.AddItem(item, newTestItem("4621300253", "Rust Book", 3000))
+	server.Catalog.AddBrowseNode(amazon.BrowseNode{ID: "1", Name: "Books"})
+	return server
+}
+
+func TestServerSignature(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, err := amazon.New("AK", "wrong", "ngsio-22", amazon.RegionJapan, amazon.WithTransport(server.Transport()), amazon.WithRateLimiter(nil))
+	Test{nil, err}.Compare(t)
+	_, err = client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+	Test{true, errors.Is(err, SignatureDoesNotMatch)}.Compare(t)
+	Test{1, server.Requests()}.Compare(t)
+
+	client, _ = amazon.New("unknown", "SK", "ngsio-22", amazon.RegionJapan, amazon.WithTransport(server.Transport()), amazon.WithRateLimiter(nil))
+	_, err = client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+	Test{true, errors.Is(err, InvalidClientTokenID)}.Compare(t)
+}
+
+func TestServerItemSearch(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	res, err := client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex: amazon.SearchIndexBooks,
+		Keywords:    "go book",
+		ItemPage:    3,
+	}).Do()
+	Test{nil, err}.Compare(t)
+	Test{25, res.Items.TotalResults}.Compare(t)
+	Test{3, res.Items.TotalPages}.Compare(t)
+	Test{5, len(res.Items.Item)}.Compare(t)
+	Test{"B000000021", res.Items.Item[0].ASIN}.Compare(t)
+
+	res, err = client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex:  amazon.SearchIndexBooks,
+		BrowseNode:   "1",
+		MinimumPrice: 1000,
+		MaximumPrice: 1200,
+	}).Do()
+	Test{nil, err}.Compare(t)
+	Test{3, len(res.Items.Item)}.Compare(t)
+	Test{"Go Book 10", res.Items.Item[0].ItemAttributes.Title}.Compare(t)
+	Test{"1000", res.Items.Item[0].ItemAttributes.ListPrice.Amount}.Compare(t)
+
+	_, err = client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex: amazon.SearchIndexBooks,
+		Keywords:    "python",
+	}).Do()
+	Test{true, errors.Is(err, amazon.NoExactMatches)}.Compare(t)
+
+	_, err = client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex: amazon.SearchIndexBooks,
+		ItemPage:    11,
+	}).Do()
+	Test{true, errors.Is(err, amazon.ParameterOutOfRange)}.Compare(t)
+}
+
+func TestServerItemSearchIterator(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	it := client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex: amazon.SearchIndexBooks,
+		Keywords:    "go",
+	}).Iterator(context.Background())
+	count := 0
+	for it.Next() {
+		count++
+	}
+	Test{nil, it.Err()}.Compare(t)
+	Test{25, count}.Compare(t)
+	Test{3, server.Requests()}.Compare(t)
+}
+
+func TestServerItemLookup(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	res, err := client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"4621300253", "B000000003"}}).Do()
+	Test{nil, err}.Compare(t)
+	Test{2, len(res.Items.Item)}.Compare(t)
+	Test{"Rust Book", res.Items.Item[0].ItemAttributes.Title}.Compare(t)
+
+	result, err := client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{"4621300253", "UNKNOWN"}}).DoPartial()
+	Test{nil, err}.Compare(t)
+	Test{[]string{"4621300253"}, result.ItemIDs(amazon.ItemLookupStatusFound)}.DeepEqual(t)
+	Test{[]string{"UNKNOWN"}, result.ItemIDs(amazon.ItemLookupStatusInvalid)}.DeepEqual(t)
+}
+
+func TestServerSimilarityLookup(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	res, err := client.SimilarityLookup(amazon.SimilarityLookupParameters{ItemIDs: []string{"B000000001"}}).Do()
+	Test{nil, err}.Compare(t)
+	Test{1, len(res.Items.Item)}.Compare(t)
+	Test{"B000000002", res.Items.Item[0].ASIN}.Compare(t)
+
+	_, err = client.SimilarityLookup(amazon.SimilarityLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+	Test{true, errors.Is(err, amazon.NoSimilarities)}.Compare(t)
+}
+
+func TestServerBrowseNodeLookup(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	res, err := client.BrowseNodeLookup(amazon.BrowseNodeLookupParameters{BrowseNodeID: "1"}).Do()
+	Test{nil, err}.Compare(t)
+	Test{"Books", res.BrowseNodes()[0].Name}.Compare(t)
+
+	_, err = client.BrowseNodeLookup(amazon.BrowseNodeLookupParameters{BrowseNodeID: "999"}).Do()
+	Test{true, errors.Is(err, amazon.InvalidParameterValue)}.Compare(t)
+}
+
+func TestServerCart(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	items := amazon.CartRequestItems{}
+	items.AddASIN("4621300253", 2)
+	created, err := client.CartCreate(amazon.CartCreateParameters{Items: items}).Do()
+	Test{nil, err}.Compare(t)
+	cart := created.Cart
+	Test{1, len(cart.CartItems.CartItem)}.Compare(t)
+	Test{"6000", cart.SubTotal.Amount}.Compare(t)
+	Test{"6000", cart.CartItems.CartItem[0].ItemTotal.Amount}.Compare(t)
+
+	items = amazon.CartRequestItems{}
+	items.AddASIN("B000000001", 1)
+	added, err := client.CartAdd(amazon.CartAddParameters{CartID: cart.ID, HMAC: cart.HMAC, Items: items}).Do()
+	Test{nil, err}.Compare(t)
+	Test{2, len(added.Cart.CartItems.CartItem)}.Compare(t)
+	Test{"6100", added.Cart.SubTotal.Amount}.Compare(t)
+
+	_, err = client.CartAdd(amazon.CartAddParameters{CartID: cart.ID, HMAC: cart.HMAC, Items: items}).Do()
+	Test{true, errors.Is(err, amazon.ItemAlreadyInCart)}.Compare(t)
+
+	modifyItems := amazon.CartModifyRequestItems{}
+	modifyItems.SaveForLater(added.Cart.CartItems.CartItem[1].ID)
+	modifyItems.ModifyQuantity(added.Cart.CartItems.CartItem[0].ID, 1)
+	modified, err := client.CartModify(amazon.CartModifyParameters{CartID: cart.ID, HMAC: cart.HMAC, Items: modifyItems}).Do()
+	Test{nil, err}.Compare(t)
+	Test{1, len(modified.Cart.CartItems.CartItem)}.Compare(t)
+	Test{"3000", modified.Cart.SubTotal.Amount}.Compare(t)
+	Test{1, len(modified.Cart.SavedForLaterItems.SavedForLaterItem)}.Compare(t)
+	Test{"B000000001", modified.Cart.SavedForLaterItems.SavedForLaterItem[0].ASIN}.Compare(t)
+
+	got, err := client.CartGet(amazon.CartGetParameters{CartID: cart.ID, HMAC: cart.HMAC}).Do()
+	Test{nil, err}.Compare(t)
+	Test{modified.Cart.CartItems, got.Cart.CartItems}.DeepEqual(t)
+
+	_, err = client.CartGet(amazon.CartGetParameters{CartID: cart.ID, HMAC: "invalid"}).Do()
+	Test{true, errors.Is(err, amazon.InvalidHMAC)}.Compare(t)
+	_, err = client.CartGet(amazon.CartGetParameters{CartID: "000-0000000-0000000", HMAC: cart.HMAC}).Do()
+	Test{true, errors.Is(err, amazon.InvalidCartID)}.Compare(t)
+
+	cleared, err := client.CartClear(amazon.CartClearParameters{CartID: cart.ID, HMAC: cart.HMAC}).Do()
+	Test{nil, err}.Compare(t)
+	Test{0, len(cleared.Cart.CartItems.CartItem)}.Compare(t)
+	Test{0, len(cleared.Cart.SavedForLaterItems.SavedForLaterItem)}.Compare(t)
+}
+
+func TestServerCartAssociateTag(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan,
+		amazon.WithAssociateTagRouter(amazon.AssociateTagByRegion(map[amazon.Region]string{amazon.RegionJapan: "routed-22"})))
+	items := amazon.CartRequestItems{}
+	items.AddASIN("4621300253", 1)
+	created, err := client.CartCreate(amazon.CartCreateParameters{Items: items}).Do()
+	Test{nil, err}.Compare(t)
+	Test{true, strings.Contains(created.Cart.PurchaseURL, "associate-id=routed-22&")}.Compare(t)
+
+	created, err = client.CartCreate(amazon.CartCreateParameters{Items: items, AssociateTag: "override-22"}).Do()
+	Test{nil, err}.Compare(t)
+	Test{true, strings.Contains(created.Cart.PurchaseURL, "associate-id=override-22&")}.Compare(t)
+}
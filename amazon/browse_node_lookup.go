@@ -1,6 +1,9 @@
 package amazon
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // BrowseNodeLookupResponseGroup represents constants those are capable ResponseGroups parameter
 type BrowseNodeLookupResponseGroup string
@@ -68,8 +71,13 @@ func (req *BrowseNodeLookupRequest) operation() string {
 
 // Do sends request for the API
 func (req *BrowseNodeLookupRequest) Do() (*BrowseNodeLookupResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *BrowseNodeLookupRequest) DoContext(ctx context.Context) (*BrowseNodeLookupResponse, error) {
 	respObj := BrowseNodeLookupResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
 	if err := respObj.Error(); err != nil {
@@ -48,7 +48,7 @@ func TestBrowseNodeLookupSignedURL(t *testing.T) {
 
 func TestBrowseNodeLookupDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createBrowseNodeLookupRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/BrowseNodeLookupResponseErrorItem.xml")
 	gock.New(expectedBrowseNodeLookupSignedURL).
@@ -63,7 +63,7 @@ func TestBrowseNodeLookupDoErrorResponse(t *testing.T) {
 }
 
 func TestBrowseNodeLookupDoError(t *testing.T) {
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createBrowseNodeLookupRequest(client)
 	gock.New(expectedBrowseNodeLookupSignedURL).
 		ReplyError(errors.New("omg"))
@@ -71,13 +71,13 @@ func TestBrowseNodeLookupDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedBrowseNodeLookupSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedBrowseNodeLookupSignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestBrowseNodeLookupDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createBrowseNodeLookupRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/BrowseNodeLookup.xml")
 	gock.New(expectedBrowseNodeLookupSignedURL).
@@ -0,0 +1,211 @@
+package amazon
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache is the interface that stores response bodies keyed by CanonicalQuery of requests
+type Cache interface {
+	// Get returns cached data and whether it is found and not expired
+	Get(key string) ([]byte, bool)
+	// Set stores data for ttl
+	Set(key string, data []byte, ttl time.Duration)
+}
+
+// DefaultCacheTTLs returns TTLs of cached responses for each operation.
+// Cart operations are never cached regardless of TTLs
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"ItemLookup":       time.Hour,
+		"ItemSearch":       time.Hour,
+		"SimilarityLookup": time.Hour,
+		"BrowseNodeLookup": 24 * time.Hour,
+	}
+}
+
+// WithCache sets Cache and TTLs of cached responses for each operation.
+// Operations without TTL are not cached. DefaultCacheTTLs is used if ttls is nil
+func WithCache(cache Cache, ttls map[string]time.Duration) Option {
+	return func(client *Client) error {
+		if ttls == nil {
+			ttls = DefaultCacheTTLs()
+		}
+		client.Cache = cache
+		client.CacheTTLs = ttls
+		return nil
+	}
+}
+
+func (client *Client) cacheEntry(op OperationRequest) (string, time.Duration, bool) {
+	if client.Cache == nil {
+		return "", 0, false
+	}
+	name := op.operation()
+	if strings.HasPrefix(name, "Cart") {
+		return "", 0, false
+	}
+	ttl := client.CacheTTLs[name]
+	if ttl <= 0 {
+		return "", 0, false
+	}
+	return client.CanonicalQuery(op), ttl, true
+}
+
+func hasResponseError(responseObject interface{}) bool {
+	if r, ok := responseObject.(responseErrorer); ok {
+		return r.Error() != nil
+	}
+	return false
+}
+
+func newCachedResponse(data []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"X-Cache": []string{"HIT"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+	}
+}
+
+// LRUCache is in-memory Cache that evicts the least recently used entry when it is full.
+// It is safe for concurrent use
+type LRUCache struct {
+	capacity int
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+type lruCacheEntry struct {
+	key     string
+	data    []byte
+	expires time.Time
+}
+
+// NewLRUCache returns new LRUCache holding up to capacity entries
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get returns cached data
+func (cache *LRUCache) Get(key string) ([]byte, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	el, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*lruCacheEntry)
+	if !cache.now().Before(entry.expires) {
+		cache.order.Remove(el)
+		delete(cache.entries, key)
+		return nil, false
+	}
+	cache.order.MoveToFront(el)
+	return entry.data, true
+}
+
+// Set stores data
+func (cache *LRUCache) Set(key string, data []byte, ttl time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	expires := cache.now().Add(ttl)
+	if el, ok := cache.entries[key]; ok {
+		entry := el.Value.(*lruCacheEntry)
+		entry.data = data
+		entry.expires = expires
+		cache.order.MoveToFront(el)
+		return
+	}
+	cache.entries[key] = cache.order.PushFront(&lruCacheEntry{key: key, data: data, expires: expires})
+	for cache.capacity > 0 && cache.order.Len() > cache.capacity {
+		el := cache.order.Back()
+		cache.order.Remove(el)
+		delete(cache.entries, el.Value.(*lruCacheEntry).key)
+	}
+}
+
+// Len returns number of entries including expired ones
+func (cache *LRUCache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.order.Len()
+}
+
+// FileCache is Cache storing each entry as a file in the directory
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache returns new FileCache storing files in dir. The directory is created if it does not exist
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+func (cache *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(cache.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get returns cached data
+func (cache *FileCache) Get(key string) ([]byte, bool) {
+	content, err := ioutil.ReadFile(cache.path(key))
+	if err != nil {
+		return nil, false
+	}
+	i := bytes.IndexByte(content, '\n')
+	if i < 0 {
+		return nil, false
+	}
+	expires, err := strconv.ParseInt(string(content[:i]), 10, 64)
+	if err != nil || cache.now().UnixNano() >= expires {
+		os.Remove(cache.path(key))
+		return nil, false
+	}
+	return content[i+1:], true
+}
+
+// Set stores data. Errors writing files are ignored as the entry is only missed on next Get
+func (cache *FileCache) Set(key string, data []byte, ttl time.Duration) {
+	f, err := ioutil.TempFile(cache.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	expires := strconv.FormatInt(cache.now().Add(ttl).UnixNano(), 10)
+	_, err = f.Write(append([]byte(expires+"\n"), data...))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+	if err := os.Rename(f.Name(), cache.path(key)); err != nil {
+		os.Remove(f.Name())
+	}
+}
@@ -0,0 +1,119 @@
+package amazon
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func TestLRUCache(t *testing.T) {
+	now := time.Date(2016, time.November, 16, 21, 34, 0, 0, time.UTC)
+	cache := NewLRUCache(2)
+	cache.now = func() time.Time { return now }
+	cache.Set("foo", []byte("1"), time.Minute)
+	cache.Set("bar", []byte("2"), time.Hour)
+	data, ok := cache.Get("foo")
+	Test{true, ok}.Compare(t)
+	Test{"1", string(data)}.Compare(t)
+	cache.Set("baz", []byte("3"), time.Hour)
+	_, ok = cache.Get("bar")
+	Test{false, ok}.Compare(t)
+	Test{2, cache.Len()}.Compare(t)
+	now = now.Add(2 * time.Minute)
+	_, ok = cache.Get("foo")
+	Test{false, ok}.Compare(t)
+	data, ok = cache.Get("baz")
+	Test{true, ok}.Compare(t)
+	Test{"3", string(data)}.Compare(t)
+	Test{1, cache.Len()}.Compare(t)
+}
+
+func TestFileCache(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazon-cache")
+	defer os.RemoveAll(dir)
+	now := time.Date(2016, time.November, 16, 21, 34, 0, 0, time.UTC)
+	cache, err := NewFileCache(dir + "/nested")
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	cache.now = func() time.Time { return now }
+	cache.Set("https://webservices.amazon.co.jp/onca/xml?Operation=ItemLookup", []byte("<foo>\n</foo>"), time.Minute)
+	data, ok := cache.Get("https://webservices.amazon.co.jp/onca/xml?Operation=ItemLookup")
+	Test{true, ok}.Compare(t)
+	Test{"<foo>\n</foo>", string(data)}.Compare(t)
+	_, ok = cache.Get("https://webservices.amazon.co.jp/onca/xml?Operation=ItemSearch")
+	Test{false, ok}.Compare(t)
+	now = now.Add(time.Minute)
+	_, ok = cache.Get("https://webservices.amazon.co.jp/onca/xml?Operation=ItemLookup")
+	Test{false, ok}.Compare(t)
+	files, _ := ioutil.ReadDir(dir + "/nested")
+	Test{0, len(files)}.Compare(t)
+}
+
+func TestClientCache(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	gock.New("https://webservices.amazon.co.jp").
+		Get("/onca/xml").
+		MatchParam("ItemId", "^4621300253$").
+		Reply(200).
+		File("_fixtures/ItemLookup.xml")
+	cache := NewLRUCache(10)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithCache(cache, nil), WithRateLimiter(nil))
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.UTC))
+	res, err := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{"4621300253", res.Items.Item[2].ASIN}.Compare(t)
+	setNow(time.Date(2016, time.November, 16, 21, 35, 0, 0, time.UTC))
+	resp := ItemLookupResponse{}
+	httpRes, err := client.DoRequest(client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"4621300253"}}), &resp)
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{"HIT", httpRes.Header.Get("X-Cache")}.Compare(t)
+	Test{"4621300253", resp.Items.Item[2].ASIN}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+	gock.New("https://webservices.amazon.co.jp").
+		Get("/onca/xml").
+		MatchParam("ItemId", "^4873117526$").
+		Reply(200).
+		File("_fixtures/ItemLookup.xml")
+	_, err = client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"4873117526"}}).Do()
+	Test{nil, err}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+	Test{2, cache.Len()}.Compare(t)
+}
+
+func TestClientCacheBypassCart(t *testing.T) {
+	doer := &mockDoer{body: "<CartGetResponse><Cart><Request><IsValid>True</IsValid></Request><CartId>1</CartId></Cart></CartGetResponse>"}
+	cache := NewLRUCache(10)
+	ttls := DefaultCacheTTLs()
+	ttls["CartGet"] = time.Hour
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithCache(cache, ttls), WithRateLimiter(nil))
+	for i := 0; i < 2; i++ {
+		if _, err := client.CartGet(CartGetParameters{CartID: "1", HMAC: "foo"}).Do(); err != nil {
+			t.Errorf("Expected nil but got %v", err)
+		}
+	}
+	Test{2, len(doer.requests)}.Compare(t)
+	Test{0, cache.Len()}.Compare(t)
+}
+
+func TestClientCacheSkipErrors(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{200, `<ItemLookupResponse><Items><Request><IsValid>True</IsValid><Errors><Error><Code>AWS.InvalidParameterValue</Code><Message>oops</Message></Error></Errors></Request></Items></ItemLookupResponse>`},
+		{200, `<ItemLookupResponse><Items><Request><IsValid>True</IsValid><Errors><Error><Code>AWS.InvalidParameterValue</Code><Message>oops</Message></Error></Errors></Request></Items></ItemLookupResponse>`},
+	}}
+	cache := NewLRUCache(10)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithCache(cache, nil), WithRateLimiter(nil))
+	for i := 0; i < 2; i++ {
+		client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"foo"}}).Do()
+	}
+	Test{2, len(doer.requests)}.Compare(t)
+	Test{0, cache.Len()}.Compare(t)
+}
@@ -1,6 +1,9 @@
 package amazon
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // CartAddResponseGroup represents constants those are capable ResponseGroups parameter
 type CartAddResponseGroup string
@@ -22,6 +25,8 @@ type CartAddParameters struct {
 	CartID         string
 	HMAC           string
 	Items          CartRequestItems
+	// AssociateTag overrides associate tag of Client for the request if not empty
+	AssociateTag string
 }
 
 // CartAddRequest represents request for CartAdd operation
@@ -51,6 +56,9 @@ func (req *CartAddRequest) Query() map[string]interface{} {
 	q["HMAC"] = req.Parameters.HMAC
 	q["Item"] = req.Parameters.Items.Query()
 	q["ResponseGroup"] = req.Parameters.ResponseGroups
+	if req.Parameters.AssociateTag != "" {
+		q["AssociateTag"] = req.Parameters.AssociateTag
+	}
 	return q
 }
 
@@ -64,8 +72,13 @@ func (req *CartAddRequest) operation() string {
 
 // Do sends request for the API
 func (req *CartAddRequest) Do() (*CartAddResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *CartAddRequest) DoContext(ctx context.Context) (*CartAddResponse, error) {
 	respObj := CartAddResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
 	if err := respObj.Error(); err != nil {
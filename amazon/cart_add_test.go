@@ -57,7 +57,7 @@ func TestCartAddSignedURL(t *testing.T) {
 
 func TestCartAddDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartAddRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartAddResponseErrorItem.xml")
 	gock.New(strings.Replace(expectedCartAddSignedURL, "%2B", "%5C%2B", 2)).
@@ -67,13 +67,13 @@ func TestCartAddDoErrorResponse(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、CartIdなどがあります。", err.Error()}.Compare(t)
+		Test{"Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、CartIdなどがあります。; Error AWS.MissingParameters: リクエストには、必要なパラメー タが含まれていません。必要なパラメータには、HMACなどがあります。; Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、Itemsなどがあります。", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartAddDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartAddRequest(client)
 	gock.New(strings.Replace(expectedCartAddSignedURL, "%2B", "%5C%2B", 2)).
 		ReplyError(errors.New("omg"))
@@ -81,13 +81,13 @@ func TestCartAddDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedCartAddSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedCartAddSignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartAddDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartAddRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartAdd.xml")
 	gock.New(strings.Replace(expectedCartAddSignedURL, "%2B", "%5C%2B", 2)).
@@ -1,6 +1,9 @@
 package amazon
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // CartClearResponseGroup represents constants those are capable ResponseGroups parameter
 type CartClearResponseGroup string
@@ -15,6 +18,8 @@ type CartClearParameters struct {
 	ResponseGroups []CartClearResponseGroup
 	CartID         string
 	HMAC           string
+	// AssociateTag overrides associate tag of Client for the request if not empty
+	AssociateTag string
 }
 
 // CartClearRequest represents request for CartClear operation
@@ -43,6 +48,9 @@ func (req *CartClearRequest) Query() map[string]interface{} {
 	q["CartId"] = req.Parameters.CartID
 	q["HMAC"] = req.Parameters.HMAC
 	q["ResponseGroup"] = req.Parameters.ResponseGroups
+	if req.Parameters.AssociateTag != "" {
+		q["AssociateTag"] = req.Parameters.AssociateTag
+	}
 	return q
 }
 
@@ -56,8 +64,13 @@ func (req *CartClearRequest) operation() string {
 
 // Do sends request for the API
 func (req *CartClearRequest) Do() (*CartClearResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *CartClearRequest) DoContext(ctx context.Context) (*CartClearResponse, error) {
 	respObj := CartClearResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
 	if err := respObj.Error(); err != nil {
@@ -48,7 +48,7 @@ func TestCartClearSignedURL(t *testing.T) {
 
 func TestCartClearDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartClearRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartClearResponseErrorItem.xml")
 	gock.New(strings.Replace(expectedCartClearSignedURL, "%2B", "%5C%2B", -1)).
@@ -58,13 +58,13 @@ func TestCartClearDoErrorResponse(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、CartIdなどがあります。", err.Error()}.Compare(t)
+		Test{"Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、CartIdなどがあります。; Error AWS.MissingParameters: リクエストには、必要なパラメー タが含まれていません。必要なパラメータには、HMACなどがあります。; Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、Itemsなどがあります。", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartClearDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartClearRequest(client)
 	gock.New(strings.Replace(expectedCartClearSignedURL, "%2B", "%5C%2B", -1)).
 		ReplyError(errors.New("omg"))
@@ -72,13 +72,13 @@ func TestCartClearDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedCartClearSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedCartClearSignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartClearDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartClearRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartClear.xml")
 	gock.New(strings.Replace(expectedCartClearSignedURL, "%2B", "%5C%2B", -1)).
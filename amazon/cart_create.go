@@ -1,6 +1,9 @@
 package amazon
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // CartCreateResponseGroup represents constants those are capable ResponseGroups parameter
 type CartCreateResponseGroup string
@@ -21,6 +24,8 @@ type CartCreateParameters struct {
 	ResponseGroups []CartCreateResponseGroup
 	ASIN           string
 	Items          CartRequestItems
+	// AssociateTag overrides associate tag of Client for the request if not empty
+	AssociateTag string
 }
 
 // CartCreateRequest represents request for CartCreate operation
@@ -48,6 +53,9 @@ func (req *CartCreateRequest) Query() map[string]interface{} {
 	q := map[string]interface{}{}
 	q["ResponseGroup"] = req.Parameters.ResponseGroups
 	q["Item"] = req.Parameters.Items.Query()
+	if req.Parameters.AssociateTag != "" {
+		q["AssociateTag"] = req.Parameters.AssociateTag
+	}
 	return q
 }
 
@@ -61,8 +69,13 @@ func (req *CartCreateRequest) operation() string {
 
 // Do sends request for the API
 func (req *CartCreateRequest) Do() (*CartCreateResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *CartCreateRequest) DoContext(ctx context.Context) (*CartCreateResponse, error) {
 	respObj := CartCreateResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
 	if err := respObj.Error(); err != nil {
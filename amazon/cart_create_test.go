@@ -56,7 +56,7 @@ func TestCartCreateSignedURL(t *testing.T) {
 
 func TestCartCreateDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartCreateRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartCreateResponseErrorItem.xml")
 	gock.New(strings.Replace(expectedCartCreateSignedURL, "%2B", "%5C%2B", -1)).
@@ -72,7 +72,7 @@ func TestCartCreateDoErrorResponse(t *testing.T) {
 
 func TestCartCreateDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartCreateRequest(client)
 	gock.New(strings.Replace(expectedCartCreateSignedURL, "%2B", "%5C%2B", -1)).
 		ReplyError(errors.New("omg"))
@@ -80,13 +80,13 @@ func TestCartCreateDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedCartCreateSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedCartCreateSignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartCreateDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartCreateRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartCreate.xml")
 	gock.New(strings.Replace(expectedCartCreateSignedURL, "%2B", "%5C%2B", -1)).
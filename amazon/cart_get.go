@@ -1,6 +1,9 @@
 package amazon
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // CartGetResponseGroup represents constants those are capable ResponseGroups parameter
 type CartGetResponseGroup string
@@ -22,6 +25,8 @@ type CartGetParameters struct {
 	CartID         string
 	CartItemID     string
 	HMAC           string
+	// AssociateTag overrides associate tag of Client for the request if not empty
+	AssociateTag string
 }
 
 // CartGetRequest represents request for CartGet operation
@@ -51,6 +56,9 @@ func (req *CartGetRequest) Query() map[string]interface{} {
 	q["CartId"] = req.Parameters.CartID
 	q["CartItemId"] = req.Parameters.CartItemID
 	q["HMAC"] = req.Parameters.HMAC
+	if req.Parameters.AssociateTag != "" {
+		q["AssociateTag"] = req.Parameters.AssociateTag
+	}
 	return q
 }
 
@@ -64,8 +72,13 @@ func (req *CartGetRequest) operation() string {
 
 // Do sends request for the API
 func (req *CartGetRequest) Do() (*CartGetResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *CartGetRequest) DoContext(ctx context.Context) (*CartGetResponse, error) {
 	respObj := CartGetResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
 	if err := respObj.Error(); err != nil {
@@ -53,7 +53,7 @@ func TestCartGetSignedURL(t *testing.T) {
 
 func TestCartGetDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartGetRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartGetResponseErrorItem.xml")
 	gock.New(strings.Replace(expectedCartGetSignedURL, "%2B", "%5C%2B", -1)).
@@ -63,13 +63,13 @@ func TestCartGetDoErrorResponse(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、CartIdなどがあります。", err.Error()}.Compare(t)
+		Test{"Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、CartIdなどがあります。; Error AWS.MissingParameters: リクエストには、必要なパラメー タが含まれていません。必要なパラメータには、HMACなどがあります。; Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、Itemsなどがあります。", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartGetDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartGetRequest(client)
 	gock.New(strings.Replace(expectedCartGetSignedURL, "%2B", "%5C%2B", -1)).
 		ReplyError(errors.New("omg"))
@@ -77,13 +77,13 @@ func TestCartGetDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedCartGetSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedCartGetSignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartGetDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartGetRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartGet.xml")
 	gock.New(strings.Replace(expectedCartGetSignedURL, "%2B", "%5C%2B", -1)).
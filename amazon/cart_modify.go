@@ -1,6 +1,9 @@
 package amazon
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // CartModifyResponseGroup represents constants those are capable ResponseGroups parameter
 type CartModifyResponseGroup string
@@ -22,6 +25,8 @@ type CartModifyParameters struct {
 	CartID         string
 	HMAC           string
 	Items          CartModifyRequestItems
+	// AssociateTag overrides associate tag of Client for the request if not empty
+	AssociateTag string
 }
 
 // CartModifyRequest represents request for CartModify operation
@@ -51,6 +56,9 @@ func (req *CartModifyRequest) Query() map[string]interface{} {
 	q["HMAC"] = req.Parameters.HMAC
 	q["Item"] = req.Parameters.Items.Query()
 	q["ResponseGroup"] = req.Parameters.ResponseGroups
+	if req.Parameters.AssociateTag != "" {
+		q["AssociateTag"] = req.Parameters.AssociateTag
+	}
 	return q
 }
 
@@ -64,8 +72,13 @@ func (req *CartModifyRequest) operation() string {
 
 // Do sends request for the API
 func (req *CartModifyRequest) Do() (*CartModifyResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *CartModifyRequest) DoContext(ctx context.Context) (*CartModifyResponse, error) {
 	respObj := CartModifyResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
 	if err := respObj.Error(); err != nil {
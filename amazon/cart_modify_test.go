@@ -68,7 +68,7 @@ func TestCartModifySignedURL(t *testing.T) {
 
 func TestCartModifyDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartModifyRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartModifyResponseErrorItem.xml")
 	gock.New(strings.Replace(expectedCartModifySignedURL, "%2B", "%5C%2B", -1)).
@@ -78,13 +78,13 @@ func TestCartModifyDoErrorResponse(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Error AWS.ExactParameterRequirement: 次のパラメータのうち、1個がリクエストに含まれている必要があります：Quantity, Action", err.Error()}.Compare(t)
+		Test{"Error AWS.ExactParameterRequirement: 次のパラメータのうち、1個がリクエストに含まれている必要があります：Quantity, Action; Error AWS.ExactParameterRequirement: 次のパラメータのうち、1個がリクエストに含まれている必要があります：Quantity, Action; Error AWS.ExactParameterRequirement: 次のパラメータのうち、1個がリクエストに含まれている必要があります：Quantity, Action", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartModifyDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartModifyRequest(client)
 	gock.New(strings.Replace(expectedCartModifySignedURL, "%2B", "%5C%2B", -1)).
 		ReplyError(errors.New("omg"))
@@ -92,13 +92,13 @@ func TestCartModifyDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedCartModifySignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedCartModifySignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestCartModifyDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createCartModifyRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/CartModify.xml")
 	gock.New(strings.Replace(expectedCartModifySignedURL, "%2B", "%5C%2B", -1)).
@@ -1,9 +1,7 @@
 package amazon
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/base64"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -12,10 +10,12 @@ import (
 	"net/url"
 	"os"
 	"reflect"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/credentials"
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/signer"
 )
 
 var timeNowFunc = time.Now
@@ -25,8 +25,6 @@ const (
 	Version string = "2013-08-01"
 	// Service is service name
 	Service string = "AWSECommerceService"
-	// timestampFormat
-	timestampFormat string = "2006-01-02T15:04:05Z"
 )
 
 // OperationRequest interface
@@ -36,53 +34,153 @@ type OperationRequest interface {
 	Query() map[string]interface{}
 }
 
+// operationValidator is implemented by operation requests those check parameters before sending
+type operationValidator interface {
+	validate() error
+}
+
+// validateOperation returns error if parameters of the operation request are invalid
+func validateOperation(op OperationRequest) error {
+	if v, ok := op.(operationValidator); ok {
+		return v.validate()
+	}
+	return nil
+}
+
+// OperationName returns name of the operation such as ItemSearch
+func OperationName(op OperationRequest) string {
+	return op.operation()
+}
+
+// AssociateTagRouter chooses associate tag of the operation request sent to the region.
+// Client.AssociateTag is used if it returns empty string
+type AssociateTagRouter func(op OperationRequest, region Region) string
+
+// AssociateTagByRegion returns AssociateTagRouter choosing tag of the region from the map
+func AssociateTagByRegion(tags map[Region]string) AssociateTagRouter {
+	return func(op OperationRequest, region Region) string {
+		return tags[region]
+	}
+}
+
+// HTTPDoer is the interface that sends HTTP requests. *http.Client satisfies it
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 // Client AWAS Client
 type Client struct {
+	// AccessKeyID and SecretAccessKey sign requests if Credentials is nil
 	AccessKeyID     string
 	SecretAccessKey string
 	AssociateTag    string
 	Secure          bool
 	Region
+	// HTTPClient sends HTTP requests. http.DefaultClient is used if nil
+	HTTPClient HTTPDoer
+	// RetryPolicy configures retries of failed requests. Requests are not retried if nil
+	RetryPolicy *RetryPolicy
+	// RateLimiter limits rate of requests. DefaultRateLimiter is used unless set with WithRateLimiter.
+	// Requests are not limited if nil
+	RateLimiter *RateLimiter
+	// MaxConcurrency is maximum number of requests sent concurrently by batch operations. 1 is used if not positive
+	MaxConcurrency int
+	// Cache stores responses of operations with TTL in CacheTTLs. Responses are not cached if nil
+	Cache Cache
+	// CacheTTLs are TTLs of cached responses keyed by operation name such as ItemLookup
+	CacheTTLs map[string]time.Duration
+	// EndpointURL overrides endpoint of Region and Secure if not empty. Set it with WithEndpoint
+	EndpointURL string
+	// Credentials provides keys on each signing. Set it with NewWithCredentials
+	Credentials credentials.Provider
+	// AssociateTagRouter chooses associate tag of each request instead of AssociateTag if not nil.
+	// AssociateTag of the request parameters takes precedence over both
+	AssociateTagRouter AssociateTagRouter
 }
 
 // New returns new client
-func New(accessKeyID string, secretAccessKey string, associateTag string, region Region) (*Client, error) {
+func New(accessKeyID string, secretAccessKey string, associateTag string, region Region, options ...Option) (*Client, error) {
 	if accessKeyID == "" {
 		return nil, errors.New("AccessKeyID is not specified")
 	}
 	if secretAccessKey == "" {
 		return nil, errors.New("SecretAccessKey is not specified")
 	}
-	if associateTag == "" {
+	return newClient(&Client{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		AssociateTag:    associateTag,
+		Region:          region,
+		Secure:          true,
+	}, options)
+}
+
+// NewWithCredentials returns new client retrieving keys from the provider on each signing
+func NewWithCredentials(provider credentials.Provider, associateTag string, region Region, options ...Option) (*Client, error) {
+	if provider == nil {
+		return nil, errors.New("Credentials is not specified")
+	}
+	return newClient(&Client{
+		Credentials:  provider,
+		AssociateTag: associateTag,
+		Region:       region,
+		Secure:       true,
+	}, options)
+}
+
+func newClient(client *Client, options []Option) (*Client, error) {
+	if client.AssociateTag == "" {
 		return nil, errors.New("AssociateTag is not specified")
 	}
-	if region == "" {
+	if client.Region == "" {
 		return nil, errors.New("Region is not specified")
 	}
-	if !region.IsValid() {
-		return nil, fmt.Errorf("Invalid Region %v", region)
+	if !client.Region.IsValid() {
+		return nil, fmt.Errorf("Invalid Region %v", client.Region)
 	}
-	return &Client{
-		AccessKeyID:     accessKeyID,
-		SecretAccessKey: secretAccessKey,
-		Region:          region,
-		AssociateTag:    associateTag,
-		Secure:          true,
-	}, nil
+	client.RateLimiter = DefaultRateLimiter
+	for _, option := range options {
+		if err := option(client); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
 }
 
 // NewFromEnvionment returns new client from environment variables
-func NewFromEnvionment() (*Client, error) {
+func NewFromEnvionment(options ...Option) (*Client, error) {
 	return New(
 		os.Getenv("AWS_ACCESS_KEY_ID"),
 		os.Getenv("AWS_SECRET_ACCESS_KEY"),
 		os.Getenv("AWS_ASSOCIATE_TAG"),
 		Region(os.Getenv("AWS_PRODUCT_REGION")),
+		options...,
 	)
 }
 
+// NewFromDefaultChain returns new client retrieving keys from credentials.NewDefaultChain on each signing.
+// Keys are read from the profile of shared credentials file (AWS_PROFILE or default)
+// if AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are not set
+func NewFromDefaultChain(options ...Option) (*Client, error) {
+	provider := credentials.NewDefaultChain()
+	if _, err := provider.Retrieve(context.Background()); err != nil {
+		return nil, err
+	}
+	return NewWithCredentials(provider, os.Getenv("AWS_ASSOCIATE_TAG"), Region(os.Getenv("AWS_PRODUCT_REGION")), options...)
+}
+
+func (client *Client) httpClient() HTTPDoer {
+	if client.HTTPClient != nil {
+		return client.HTTPClient
+	}
+	return http.DefaultClient
+}
+
 // Endpoint returns API endpoint
 func (client *Client) Endpoint() string {
+	if client.EndpointURL != "" {
+		return client.EndpointURL
+	}
 	if client.Secure {
 		return client.Region.HTTPSEndpoint()
 	}
@@ -139,92 +237,184 @@ func setQueryValue(q url.Values, key string, value interface{}) url.Values {
 	return q
 }
 
-func (client *Client) fillQuery(op OperationRequest) url.Values {
-	ep := client.Endpoint()
+// retrieveCredentials returns keys from Credentials, or AccessKeyID and SecretAccessKey if Credentials is nil
+func (client *Client) retrieveCredentials(ctx context.Context) (credentials.Value, error) {
+	if client.Credentials == nil {
+		return credentials.NewStatic(client.AccessKeyID, client.SecretAccessKey, "").Retrieve(ctx)
+	}
+	return client.Credentials.Retrieve(ctx)
+}
+
+// associateTag returns tag chosen by AssociateTagRouter, or AssociateTag
+func (client *Client) associateTag(op OperationRequest) string {
+	if client.AssociateTagRouter != nil {
+		if tag := client.AssociateTagRouter(op, client.Region); tag != "" {
+			return tag
+		}
+	}
+	return client.AssociateTag
+}
+
+func (client *Client) unsignedQuery(op OperationRequest, accessKeyID string) url.Values {
 	q := url.Values{}
-	u, _ := url.Parse(ep)
 	qmap := op.Query()
 	q.Set("Service", Service)
-	q.Set("AWSAccessKeyId", client.AccessKeyID)
+	q.Set("AWSAccessKeyId", accessKeyID)
 	q.Set("Version", Version)
 	q.Set("Operation", op.operation())
-	if client.AssociateTag != "" {
-		q.Set("AssociateTag", client.AssociateTag)
+	if tag := client.associateTag(op); tag != "" {
+		q.Set("AssociateTag", tag)
 	}
-	ts := timeNowFunc().UTC().Format(timestampFormat)
-	q.Set("Timestamp", ts)
 	for k, v := range qmap {
 		q = setQueryValue(q, k, v)
 	}
-	queryKeys := make([]string, 0, len(q))
-	for key := range q {
-		queryKeys = append(queryKeys, key)
-	}
-	sort.Strings(queryKeys)
-	queryKeysAndValues := make([]string, len(queryKeys))
-	for i, key := range queryKeys {
-		k := strings.Replace(url.QueryEscape(key), "+", "%20", -1)
-		v := strings.Replace(url.QueryEscape(q.Get(key)), "+", "%20", -1)
-		queryKeysAndValues[i] = k + "=" + v
-	}
-	query := strings.Join(queryKeysAndValues, "&")
-	msg := op.httpMethod() + "\n" + u.Host + "\n" + u.Path + "\n" + query
-	mac := hmac.New(sha256.New, []byte(client.SecretAccessKey))
-	mac.Write([]byte(msg))
-	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
-	q.Set("Signature", signature)
 	return q
 }
 
-// SignedURL returns signed URL with specified query
+func (client *Client) fillQuery(op OperationRequest, creds credentials.Value) url.Values {
+	u, _ := url.Parse(client.Endpoint())
+	q := client.unsignedQuery(op, creds.AccessKeyID)
+	signer.New(creds.AccessKeyID, creds.SecretAccessKey).SignQuery(op.httpMethod(), u, q, timeNowFunc())
+	return q
+}
+
+// CanonicalQuery returns endpoint and sorted query for the operation without Timestamp and Signature,
+// which identifies the request regardless of when it is sent
+func (client *Client) CanonicalQuery(op OperationRequest) string {
+	return client.Endpoint() + "?" + signer.CanonicalQueryString(client.unsignedQuery(op, client.AccessKeyID))
+}
+
+// SignedURL returns signed URL with specified query.
+// It returns empty string if credentials are not retrieved; use SignedURLContext to get the error
 func (client *Client) SignedURL(op OperationRequest) string {
-	ep := client.Endpoint()
-	url, _ := url.Parse(ep)
-	q := client.fillQuery(op)
-	url.RawQuery = q.Encode()
+	url, _ := client.SignedURLContext(context.Background(), op)
+	return url
+}
+
+// SignedURLContext returns signed URL with specified query, retrieving credentials with the context
+func (client *Client) SignedURLContext(ctx context.Context, op OperationRequest) (string, error) {
+	if err := validateOperation(op); err != nil {
+		return "", err
+	}
+	creds, err := client.retrieveCredentials(ctx)
+	if err != nil {
+		return "", err
+	}
+	return client.signedURL(op, creds), nil
+}
+
+func (client *Client) signedURL(op OperationRequest, creds credentials.Value) string {
+	url, _ := url.Parse(client.Endpoint())
+	url.RawQuery = client.fillQuery(op, creds).Encode()
 	return url.String()
 }
 
 // DoRequest sends HTTP request
 func (client *Client) DoRequest(op OperationRequest, responseObject interface{}) (*http.Response, error) {
-	url := client.SignedURL(op)
+	return client.DoRequestContext(context.Background(), op, responseObject)
+}
+
+// DoRequestContext sends HTTP request with the context.
+// If the context is canceled or its deadline is exceeded, ctx.Err() is returned as is.
+// The request is signed again and retried as configured with RetryPolicy, unless the operation is not idempotent such as CartAdd
+func (client *Client) DoRequestContext(ctx context.Context, op OperationRequest, responseObject interface{}) (*http.Response, error) {
+	if err := validateOperation(op); err != nil {
+		return nil, err
+	}
+	cacheKey, cacheTTL, cacheable := client.cacheEntry(op)
+	if cacheable {
+		if data, ok := client.Cache.Get(cacheKey); ok {
+			if err := xml.Unmarshal(data, responseObject); err == nil {
+				return newCachedResponse(data), nil
+			}
+			resetResponseObject(responseObject)
+		}
+	}
+	for attempt := 1; ; attempt++ {
+		res, data, err := client.doRequest(ctx, op, responseObject)
+		if policy := client.RetryPolicy; policy != nil && attempt < policy.MaxAttempts && policy.shouldRetry(op, res, err, responseObject) {
+			if err := policy.wait(ctx, attempt); err != nil {
+				return nil, err
+			}
+			resetResponseObject(responseObject)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if cacheable && !hasResponseError(responseObject) {
+			client.Cache.Set(cacheKey, data, cacheTTL)
+		}
+		return res, nil
+	}
+}
+
+func (client *Client) doRequest(ctx context.Context, op OperationRequest, responseObject interface{}) (*http.Response, []byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	creds, err := client.retrieveCredentials(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client.RateLimiter != nil {
+		if err := client.RateLimiter.Wait(ctx, creds.AccessKeyID); err != nil {
+			return nil, nil, err
+		}
+	}
 	method := op.httpMethod()
-	var res *http.Response
-	var err error
+	var req *http.Request
 
 	switch strings.ToUpper(method) {
 	case "GET":
-		res, err = http.Get(url)
-		break
+		req, err = http.NewRequest("GET", client.signedURL(op, creds), nil)
 	case "POST":
-		res, err = http.PostForm(client.Endpoint(), client.fillQuery(op))
-		break
+		req, err = http.NewRequest("POST", client.Endpoint(), strings.NewReader(client.fillQuery(op, creds).Encode()))
+		if req != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
 	default:
-		return nil, fmt.Errorf("Unsupported HTTP method: %v", method)
+		return nil, nil, fmt.Errorf("Unsupported HTTP method: %v", method)
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	if data, _ := ioutil.ReadAll(res.Body); data != nil {
-		// fmt.Println(string(data))
-		if err = xml.Unmarshal(data, responseObject); err != nil {
-			for _, fn := range []func([]byte) error{
-				newItemSearchErrorResponse,
-				newBrowseNodeLookupErrorResponse,
-				newItemLookupErrorResponse,
-				newSimilarityLookupErrorResponse,
-				newCartAddErrorResponse,
-				newCartClearErrorResponse,
-				newCartCreateErrorResponse,
-				newCartGetErrorResponse,
-				newCartModifyErrorResponse,
-			} {
-				if e := fn(data); e != nil {
-					return nil, e
-				}
-			}
-			return nil, err
+	res, err := client.httpClient().Do(req.WithContext(ctx))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
 		}
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return res, data, err
+	}
+	// fmt.Println(string(data))
+	success := res.StatusCode >= 200 && res.StatusCode < 300
+	if success {
+		if err = xml.Unmarshal(data, responseObject); err == nil {
+			return res, data, nil
+		}
+	}
+	for _, fn := range []func([]byte) error{
+		newItemSearchErrorResponse,
+		newBrowseNodeLookupErrorResponse,
+		newItemLookupErrorResponse,
+		newSimilarityLookupErrorResponse,
+		newCartAddErrorResponse,
+		newCartClearErrorResponse,
+		newCartCreateErrorResponse,
+		newCartGetErrorResponse,
+		newCartModifyErrorResponse,
+	} {
+		if e := fn(data); e != nil {
+			return res, data, e
+		}
+	}
+	if !success {
+		return res, data, newHTTPError(res, data)
 	}
-	return res, err
+	return res, data, err
 }
@@ -1,19 +1,35 @@
 package amazon
 
 import (
+	"context"
 	"encoding/xml"
 	"errors"
+	"io/ioutil"
+	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/credentials"
 	gock "gopkg.in/h2non/gock.v1"
 )
 
 const expectedGetBody = "AWSAccessKeyId=AK&AssociateTag=ngsio-22&Operation=Mock&Service=AWSECommerceService&Signature=wHPsmXHNme%2B%2F1bb39wTxqB51YgB2xBRe2r5WOzfqViQ%3D&Timestamp=2016-11-16T12%3A34%3A00Z&Version=2013-08-01&array.1=foo&array.2=bar&array.3=baz&falsy=False&int=100&map.1.baz1=qux1&map.1.foo1=bar1&map.2.baz2=qux2&map.2.foo2=bar2&string=bar&truthy=True&uint=200"
 const expectedPostBody = "AWSAccessKeyId=AK&AssociateTag=ngsio-22&Operation=Mock&Service=AWSECommerceService&Signature=yO2WsclEMEc357Q%2BCMSFn%2FNRh6DWbaJZM2zySysY%2F0A%3D&Timestamp=2016-11-16T12%3A34%3A00Z&Version=2013-08-01&array.1=foo&array.2=bar&array.3=baz&falsy=False&int=100&map.1.baz1=qux1&map.1.foo1=bar1&map.2.baz2=qux2&map.2.foo2=bar2&string=bar&truthy=True&uint=200"
 
+// expectedGetParams returns gock matchers of the query params in expectedGetBody
+func expectedGetParams() map[string]string {
+	q, _ := url.ParseQuery(expectedGetBody)
+	params := map[string]string{}
+	for key := range q {
+		params[key] = "^" + regexp.QuoteMeta(q.Get(key)) + "$"
+	}
+	return params
+}
+
 func setNow(t time.Time) {
 	timeNowFunc = func() time.Time { return t }
 }
@@ -103,6 +119,93 @@ func TestNewFromEnvionment(t *testing.T) {
 	}
 }
 
+func TestNewFromDefaultChain(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazon-credentials")
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "credentials")
+	ioutil.WriteFile(filename, []byte("[affiliate]\naws_access_key_id = AK\naws_secret_access_key = SK\n"), 0600)
+	os.Setenv("AWS_ACCESS_KEY_ID", "")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filename)
+	os.Setenv("AWS_PROFILE", "affiliate")
+	os.Setenv("AWS_PRODUCT_REGION", "JP")
+	os.Setenv("AWS_ASSOCIATE_TAG", "ngsio-22")
+	defer os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "")
+	defer os.Setenv("AWS_PROFILE", "")
+	_, err := NewFromEnvionment()
+	Test{"AccessKeyID is not specified", err.Error()}.Compare(t)
+	client, err := NewFromDefaultChain()
+	Test{nil, err}.Compare(t)
+	Test{"", client.AccessKeyID}.Compare(t)
+	creds, err := client.retrieveCredentials(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{"AK", creds.AccessKeyID}.Compare(t)
+
+	os.Setenv("AWS_PROFILE", "missing")
+	_, err = NewFromDefaultChain()
+	Test{"No valid credentials: AWS_ACCESS_KEY_ID is not set; Profile missing is not found in " + filename, err.Error()}.Compare(t)
+}
+
+func TestNewWithCredentials(t *testing.T) {
+	provider := credentials.NewStatic("AK", "SK", "")
+	client, err := NewWithCredentials(provider, "ngsio-22", RegionJapan)
+	Test{nil, err}.Compare(t)
+	Test{provider, client.Credentials}.Compare(t)
+	Test{"ngsio-22", client.AssociateTag}.Compare(t)
+	Test{true, client.Secure}.Compare(t)
+
+	_, err = NewWithCredentials(nil, "ngsio-22", RegionJapan)
+	Test{"Credentials is not specified", err.Error()}.Compare(t)
+	_, err = NewWithCredentials(provider, "", RegionJapan)
+	Test{"AssociateTag is not specified", err.Error()}.Compare(t)
+	_, err = NewWithCredentials(provider, "ngsio-22", "JAPAN")
+	Test{"Invalid Region JAPAN", err.Error()}.Compare(t)
+}
+
+// rotatingProvider returns keys in order on each Retrieve
+type rotatingProvider struct {
+	keys []string
+}
+
+func (p *rotatingProvider) Retrieve(ctx context.Context) (credentials.Value, error) {
+	if len(p.keys) == 0 {
+		return credentials.Value{}, errors.New("No more keys")
+	}
+	key := p.keys[0]
+	p.keys = p.keys[1:]
+	return credentials.Value{AccessKeyID: key, SecretAccessKey: "SK"}, nil
+}
+
+func TestClientCredentialsRotation(t *testing.T) {
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	doer := &mockDoer{body: "<mock><result>OK</result></mock>"}
+	client, _ := NewWithCredentials(&rotatingProvider{keys: []string{"AK", "AK2"}}, "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	for _, key := range []string{"AK", "AK2"} {
+		res := mockResponse{}
+		_, err := client.DoRequest(&mockOperation{}, &res)
+		Test{nil, err}.Compare(t)
+		Test{key, doer.requests[len(doer.requests)-1].URL.Query().Get("AWSAccessKeyId")}.Compare(t)
+	}
+	Test{"https://webservices.amazon.co.jp/onca/xml?" + expectedGetBody, doer.requests[0].URL.String()}.Compare(t)
+
+	_, err := client.DoRequest(&mockOperation{}, &mockResponse{})
+	Test{"No more keys", err.Error()}.Compare(t)
+	Test{2, len(doer.requests)}.Compare(t)
+}
+
+func TestClientSignedURLContext(t *testing.T) {
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	client, _ := NewWithCredentials(&rotatingProvider{keys: []string{"AK"}}, "ngsio-22", RegionJapan)
+	url, err := client.SignedURLContext(context.Background(), &mockOperation{})
+	Test{nil, err}.Compare(t)
+	Test{"https://webservices.amazon.co.jp/onca/xml?" + expectedGetBody, url}.Compare(t)
+
+	url, err = client.SignedURLContext(context.Background(), &mockOperation{})
+	Test{"No more keys", err.Error()}.Compare(t)
+	Test{"", url}.Compare(t)
+	Test{"", client.SignedURL(&mockOperation{})}.Compare(t)
+}
+
 func TestClientEndpoint(t *testing.T) {
 	secureClient, _ := New("AK", "SK", "ngsio-22", RegionJapan)
 	insecureClient, _ := New("AK", "SK", "ngsio-22", RegionJapan)
@@ -162,6 +265,50 @@ func TestClientSignedURL(t *testing.T) {
 	}.Compare(t)
 }
 
+func TestClientAssociateTagRouter(t *testing.T) {
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	var routed []string
+	router := func(op OperationRequest, region Region) string {
+		routed = append(routed, OperationName(op)+" "+string(region))
+		if _, ok := op.(*ItemLookupRequest); ok {
+			return ""
+		}
+		return "routed-22"
+	}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithAssociateTagRouter(router))
+	u, _ := url.Parse(client.SignedURL(client.ItemSearch(ItemSearchParameters{Keywords: "Go"})))
+	Test{"routed-22", u.Query().Get("AssociateTag")}.Compare(t)
+	u, _ = url.Parse(client.SignedURL(client.ItemSearch(ItemSearchParameters{Keywords: "Go", AssociateTag: "override-22"})))
+	Test{"override-22", u.Query().Get("AssociateTag")}.Compare(t)
+	u, _ = url.Parse(client.SignedURL(client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"4621300253"}})))
+	Test{"ngsio-22", u.Query().Get("AssociateTag")}.Compare(t)
+	Test{[]string{"ItemSearch JP", "ItemSearch JP", "ItemLookup JP"}, routed}.DeepEqual(t)
+
+	Test{
+		"https://webservices.amazon.co.jp/onca/xml?AWSAccessKeyId=AK&AssociateTag=override-22&Keywords=Go&Operation=ItemSearch&ResponseGroup=&Service=AWSECommerceService&Version=2013-08-01",
+		client.CanonicalQuery(client.ItemSearch(ItemSearchParameters{Keywords: "Go", AssociateTag: "override-22"})),
+	}.Compare(t)
+}
+
+func TestAssociateTagByRegion(t *testing.T) {
+	router := AssociateTagByRegion(map[Region]string{RegionUS: "ngsio-20"})
+	Test{"ngsio-20", router(&mockOperation{}, RegionUS)}.Compare(t)
+	Test{"", router(&mockOperation{}, RegionJapan)}.Compare(t)
+}
+
+func TestClientCanonicalQuery(t *testing.T) {
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	q1 := client.CanonicalQuery(&mockOperation{})
+	setNow(time.Date(2016, time.November, 17, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	q2 := client.CanonicalQuery(&mockOperation{})
+	Test{
+		"https://webservices.amazon.co.jp/onca/xml?AWSAccessKeyId=AK&AssociateTag=ngsio-22&Operation=Mock&Service=AWSECommerceService&Version=2013-08-01&array.1=foo&array.2=bar&array.3=baz&falsy=False&int=100&map.1.baz1=qux1&map.1.foo1=bar1&map.2.baz2=qux2&map.2.foo2=bar2&string=bar&truthy=True&uint=200",
+		q1,
+	}.Compare(t)
+	Test{q1, q2}.Compare(t)
+}
+
 func TestDoGetRequest(t *testing.T) {
 	defer gock.Off()
 	t.SkipNow()
@@ -170,7 +317,7 @@ func TestDoGetRequest(t *testing.T) {
 	gock.New("https://webservices.amazon.co.jp/onca/xml?" + expectedGetBody).
 		Reply(200).
 		BodyString("<mock><result>OK</result></mock>")
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	client.AssociateTag = "ngsio-22"
 	mockOp := &mockOperation{}
 	mockResp := mockResponse{}
@@ -191,7 +338,7 @@ func TestDoPostRequest(t *testing.T) {
 		BodyString(expectedPostBody).
 		Reply(200).
 		BodyString("<mock><result>OK</result></mock>")
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	client.AssociateTag = "ngsio-22"
 	mockOp := &mockOperation{
 		method: "POST",
@@ -207,7 +354,7 @@ func TestDoPostRequest(t *testing.T) {
 
 func TestDoInvalidMethodRequest(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	client.AssociateTag = "ngsio-22"
 	mockOp := &mockOperation{
 		method: "DELETE",
@@ -223,15 +370,15 @@ func TestDoInvalidMethodRequest(t *testing.T) {
 func TestDoHTTPError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
 	gock.New("https://webservices.amazon.co.jp/onca/xml").
-		BodyString(expectedGetBody).
+		MatchParams(expectedGetParams()).
 		ReplyError(errors.New("oops"))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	client.AssociateTag = "ngsio-22"
 	mockOp := &mockOperation{}
 	mockResp := mockResponse{}
 	res, err := client.DoRequest(mockOp, &mockResp)
 	Test{
-		"Get " + "https://webservices.amazon.co.jp/onca/xml?" + expectedGetBody + ": oops",
+		"Get \"https://webservices.amazon.co.jp/onca/xml?" + expectedGetBody + "\": oops",
 		err.Error()}.Compare(t)
 	if res != nil {
 		t.Errorf("Expected nil but got %v", res)
@@ -241,10 +388,10 @@ func TestDoHTTPError(t *testing.T) {
 func TestDoInvalidXML(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
 	gock.New("https://webservices.amazon.co.jp/onca/xml").
-		BodyString(expectedGetBody).
+		MatchParams(expectedGetParams()).
 		Reply(200).
 		BodyString("<invalidmock><result>OK</result></invalidmock>")
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	client.AssociateTag = "ngsio-22"
 	mockOp := &mockOperation{}
 	mockResp := mockResponse{}
@@ -272,10 +419,10 @@ func TestDoErrorResponse(t *testing.T) {
 	} {
 		fixtureIO, _ := os.Open("_fixtures/" + op + "ErrorResponse.xml")
 		gock.New("https://webservices.amazon.co.jp/onca/xml").
-			BodyString(expectedGetBody).
+			MatchParams(expectedGetParams()).
 			Reply(200).
 			Body(fixtureIO)
-		client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+		client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 		client.AssociateTag = "ngsio-22"
 		setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
 		mockOp := &mockOperation{}
@@ -290,3 +437,23 @@ func TestDoErrorResponse(t *testing.T) {
 	}
 
 }
+
+func TestDoRequestContextCanceled(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	gock.New("https://webservices.amazon.co.jp/onca/xml?" + expectedGetBody).
+		Reply(200).
+		BodyString("<mock><result>OK</result></mock>")
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockResp := mockResponse{}
+	res, err := client.DoRequestContext(ctx, &mockOperation{}, &mockResp)
+	if err != context.Canceled {
+		t.Errorf("Expected %v but got %v", context.Canceled, err)
+	}
+	if res != nil {
+		t.Errorf("Expected nil but got %v", res)
+	}
+}
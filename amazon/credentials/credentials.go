@@ -0,0 +1,193 @@
+// Package credentials provides AWS credentials to sign requests, which are retrieved on each signing
+// so that rotated keys are picked up without recreating clients.
+//
+//	provider := credentials.NewCache(credentials.NewChain(
+//		&credentials.EnvProvider{},
+//		&credentials.SharedFileProvider{Profile: "affiliate"},
+//	), credentials.DefaultExpiryWindow)
+//	client, _ := amazon.NewWithCredentials(provider, "ngsio-22", amazon.RegionJapan)
+package credentials
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Value is AWS credentials
+type Value struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is token of temporary credentials, sent with requests signed with Signature Version 4
+	SessionToken string
+	// Expires is when the credentials expire. The credentials never expire if zero
+	Expires time.Time
+	// Source is name of the provider that retrieved the credentials
+	Source string
+}
+
+// HasKeys returns whether both AccessKeyID and SecretAccessKey are set
+func (v Value) HasKeys() bool {
+	return v.AccessKeyID != "" && v.SecretAccessKey != ""
+}
+
+// validate returns error if any key is missing
+func (v Value) validate() error {
+	if v.AccessKeyID == "" {
+		return errors.New("AccessKeyID is not specified")
+	}
+	if v.SecretAccessKey == "" {
+		return errors.New("SecretAccessKey is not specified")
+	}
+	return nil
+}
+
+// Provider provides credentials. Retrieve is called on each signing unless the provider is wrapped with Cache
+type Provider interface {
+	Retrieve(ctx context.Context) (Value, error)
+}
+
+// StaticProvider provides fixed credentials
+type StaticProvider struct {
+	Value
+}
+
+// NewStatic returns StaticProvider with the keys
+func NewStatic(accessKeyID string, secretAccessKey string, sessionToken string) *StaticProvider {
+	return &StaticProvider{Value: Value{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SessionToken:    sessionToken,
+	}}
+}
+
+// Retrieve returns the credentials
+func (p *StaticProvider) Retrieve(ctx context.Context) (Value, error) {
+	if err := p.Value.validate(); err != nil {
+		return Value{}, err
+	}
+	v := p.Value
+	v.Source = "StaticProvider"
+	return v, nil
+}
+
+// EnvProvider provides credentials from environment variables
+// AWS_ACCESS_KEY_ID or AWS_ACCESS_KEY, AWS_SECRET_ACCESS_KEY or AWS_SECRET_KEY, and AWS_SESSION_TOKEN
+type EnvProvider struct{}
+
+// Retrieve reads the environment variables
+func (p *EnvProvider) Retrieve(ctx context.Context) (Value, error) {
+	v := Value{
+		AccessKeyID:     firstEnv("AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY"),
+		SecretAccessKey: firstEnv("AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		Source:          "EnvProvider",
+	}
+	if v.AccessKeyID == "" {
+		return Value{}, errors.New("AWS_ACCESS_KEY_ID is not set")
+	}
+	if v.SecretAccessKey == "" {
+		return Value{}, errors.New("AWS_SECRET_ACCESS_KEY is not set")
+	}
+	return v, nil
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// ChainProvider provides credentials from the first provider succeeded
+type ChainProvider struct {
+	Providers []Provider
+}
+
+// NewChain returns ChainProvider trying the providers in order
+func NewChain(providers ...Provider) *ChainProvider {
+	return &ChainProvider{Providers: providers}
+}
+
+// NewDefaultChain returns ChainProvider trying EnvProvider then SharedFileProvider with the default file and profile
+func NewDefaultChain() *ChainProvider {
+	return NewChain(&EnvProvider{}, &SharedFileProvider{})
+}
+
+// Retrieve returns credentials of the first provider succeeded, or error joining errors of all providers
+func (p *ChainProvider) Retrieve(ctx context.Context) (Value, error) {
+	if len(p.Providers) == 0 {
+		return Value{}, errors.New("No credential providers")
+	}
+	messages := make([]string, 0, len(p.Providers))
+	for _, provider := range p.Providers {
+		v, err := provider.Retrieve(ctx)
+		if err == nil {
+			return v, nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return Value{}, ctxErr
+		}
+		messages = append(messages, err.Error())
+	}
+	return Value{}, errors.New("No valid credentials: " + strings.Join(messages, "; "))
+}
+
+// DefaultExpiryWindow is duration before expiry when Cache refreshes credentials
+const DefaultExpiryWindow = 5 * time.Minute
+
+// Cache caches credentials of the provider until ExpiryWindow before they expire.
+// Credentials without expiry are cached until Expire is called. It is safe for concurrent use
+type Cache struct {
+	Provider     Provider
+	ExpiryWindow time.Duration
+
+	mu     sync.Mutex
+	value  Value
+	cached bool
+	now    func() time.Time
+}
+
+// NewCache returns Cache of the provider refreshing credentials expiryWindow before they expire
+func NewCache(provider Provider, expiryWindow time.Duration) *Cache {
+	return &Cache{Provider: provider, ExpiryWindow: expiryWindow}
+}
+
+// Retrieve returns cached credentials, or retrieves them from the provider if not cached or expiring
+func (c *Cache) Retrieve(ctx context.Context) (Value, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cached && !c.expiring() {
+		return c.value, nil
+	}
+	v, err := c.Provider.Retrieve(ctx)
+	if err != nil {
+		return Value{}, err
+	}
+	c.value, c.cached = v, true
+	return v, nil
+}
+
+// Expire discards cached credentials so that next Retrieve retrieves them from the provider
+func (c *Cache) Expire() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.value, c.cached = Value{}, false
+}
+
+// expiring returns whether cached credentials are in ExpiryWindow. It must be called with c.mu locked
+func (c *Cache) expiring() bool {
+	if c.value.Expires.IsZero() {
+		return false
+	}
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	return !now().Add(c.ExpiryWindow).Before(c.value.Expires)
+}
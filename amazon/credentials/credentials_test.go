@@ -0,0 +1,163 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type Test struct {
+	expected interface{}
+	actual   interface{}
+}
+
+func (test Test) Compare(t *testing.T) {
+	if test.expected != test.actual {
+		t.Errorf(`Expected "%v" but got "%v"`, test.expected, test.actual)
+	}
+}
+
+func (test Test) DeepEqual(t *testing.T) {
+	if !reflect.DeepEqual(test.expected, test.actual) {
+		t.Errorf(`Expected "%v" but got "%v"`, test.expected, test.actual)
+	}
+}
+
+// countingProvider returns AccessKeyID numbered by calls of Retrieve
+type countingProvider struct {
+	calls   int
+	expires time.Time
+	err     error
+}
+
+func (p *countingProvider) Retrieve(ctx context.Context) (Value, error) {
+	p.calls++
+	if p.err != nil {
+		return Value{}, p.err
+	}
+	return Value{AccessKeyID: "AK" + string(rune('0'+p.calls)), SecretAccessKey: "SK", Expires: p.expires}, nil
+}
+
+// setenv sets the environment variables and returns function restoring them
+func setenv(env map[string]string) func() {
+	prev := map[string]string{}
+	for key, value := range env {
+		prev[key] = os.Getenv(key)
+		os.Setenv(key, value)
+	}
+	return func() {
+		for key, value := range prev {
+			os.Setenv(key, value)
+		}
+	}
+}
+
+func TestValueHasKeys(t *testing.T) {
+	Test{true, Value{AccessKeyID: "AK", SecretAccessKey: "SK"}.HasKeys()}.Compare(t)
+	Test{false, Value{AccessKeyID: "AK"}.HasKeys()}.Compare(t)
+	Test{false, Value{SecretAccessKey: "SK"}.HasKeys()}.Compare(t)
+}
+
+func TestStaticProvider(t *testing.T) {
+	v, err := NewStatic("AK", "SK", "TOKEN").Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{Value{AccessKeyID: "AK", SecretAccessKey: "SK", SessionToken: "TOKEN", Source: "StaticProvider"}, v}.DeepEqual(t)
+
+	_, err = NewStatic("", "SK", "").Retrieve(context.Background())
+	Test{"AccessKeyID is not specified", err.Error()}.Compare(t)
+	_, err = NewStatic("AK", "", "").Retrieve(context.Background())
+	Test{"SecretAccessKey is not specified", err.Error()}.Compare(t)
+}
+
+func TestEnvProvider(t *testing.T) {
+	defer setenv(map[string]string{
+		"AWS_ACCESS_KEY_ID":     "AK",
+		"AWS_ACCESS_KEY":        "AK2",
+		"AWS_SECRET_ACCESS_KEY": "",
+		"AWS_SECRET_KEY":        "SK2",
+		"AWS_SESSION_TOKEN":     "TOKEN",
+	})()
+	v, err := (&EnvProvider{}).Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{Value{AccessKeyID: "AK", SecretAccessKey: "SK2", SessionToken: "TOKEN", Source: "EnvProvider"}, v}.DeepEqual(t)
+
+	os.Setenv("AWS_SECRET_KEY", "")
+	_, err = (&EnvProvider{}).Retrieve(context.Background())
+	Test{"AWS_SECRET_ACCESS_KEY is not set", err.Error()}.Compare(t)
+
+	os.Setenv("AWS_ACCESS_KEY_ID", "")
+	os.Setenv("AWS_ACCESS_KEY", "")
+	_, err = (&EnvProvider{}).Retrieve(context.Background())
+	Test{"AWS_ACCESS_KEY_ID is not set", err.Error()}.Compare(t)
+}
+
+func TestChainProvider(t *testing.T) {
+	chain := NewChain(&countingProvider{err: errors.New("first")}, NewStatic("AK", "SK", ""), NewStatic("AK2", "SK2", ""))
+	v, err := chain.Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{"AK", v.AccessKeyID}.Compare(t)
+	Test{"StaticProvider", v.Source}.Compare(t)
+
+	chain = NewChain(&countingProvider{err: errors.New("first")}, NewStatic("", "", ""))
+	_, err = chain.Retrieve(context.Background())
+	Test{"No valid credentials: first; AccessKeyID is not specified", err.Error()}.Compare(t)
+
+	_, err = NewChain().Retrieve(context.Background())
+	Test{"No credential providers", err.Error()}.Compare(t)
+}
+
+func TestChainProviderCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	second := &countingProvider{}
+	_, err := NewChain(&countingProvider{err: errors.New("first")}, second).Retrieve(ctx)
+	Test{context.Canceled, err}.Compare(t)
+	Test{0, second.calls}.Compare(t)
+}
+
+func TestCache(t *testing.T) {
+	now := time.Date(2016, time.November, 16, 12, 34, 0, 0, time.UTC)
+	provider := &countingProvider{expires: now.Add(10 * time.Minute)}
+	cache := NewCache(provider, DefaultExpiryWindow)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		v, err := cache.Retrieve(context.Background())
+		Test{nil, err}.Compare(t)
+		Test{"AK1", v.AccessKeyID}.Compare(t)
+	}
+	Test{1, provider.calls}.Compare(t)
+
+	now = now.Add(5 * time.Minute)
+	v, _ := cache.Retrieve(context.Background())
+	Test{"AK2", v.AccessKeyID}.Compare(t)
+	Test{2, provider.calls}.Compare(t)
+
+	cache.Expire()
+	v, _ = cache.Retrieve(context.Background())
+	Test{"AK3", v.AccessKeyID}.Compare(t)
+}
+
+func TestCacheWithoutExpiry(t *testing.T) {
+	provider := &countingProvider{}
+	cache := NewCache(provider, time.Minute)
+	cache.now = func() time.Time { return time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC) }
+	cache.Retrieve(context.Background())
+	v, _ := cache.Retrieve(context.Background())
+	Test{"AK1", v.AccessKeyID}.Compare(t)
+	Test{1, provider.calls}.Compare(t)
+}
+
+func TestCacheError(t *testing.T) {
+	provider := &countingProvider{err: errors.New("omg")}
+	cache := NewCache(provider, time.Minute)
+	_, err := cache.Retrieve(context.Background())
+	Test{"omg", err.Error()}.Compare(t)
+	provider.err = nil
+	v, err := cache.Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{"AK2", v.AccessKeyID}.Compare(t)
+}
@@ -0,0 +1,114 @@
+package credentials
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is profile used if neither SharedFileProvider.Profile nor AWS_PROFILE is set
+const DefaultProfile = "default"
+
+// SharedFileProvider provides credentials from a profile of shared credentials file in ini format.
+// The file is read on each Retrieve, so that rotated keys written to the file are picked up.
+//
+//	[default]
+//	aws_access_key_id = AKID
+//	aws_secret_access_key = SECRET
+//	aws_session_token = TOKEN
+//
+// Sections named as [profile name] in ~/.aws/config are also recognized
+type SharedFileProvider struct {
+	// Filename is path of the file. AWS_SHARED_CREDENTIALS_FILE or ~/.aws/credentials is used if empty
+	Filename string
+	// Profile is name of the profile. AWS_PROFILE or DefaultProfile is used if empty
+	Profile string
+}
+
+func (p *SharedFileProvider) filename() string {
+	if p.Filename != "" {
+		return p.Filename
+	}
+	if filename := os.Getenv("AWS_SHARED_CREDENTIALS_FILE"); filename != "" {
+		return filename
+	}
+	home := os.Getenv("HOME")
+	if home == "" {
+		home = os.Getenv("USERPROFILE")
+	}
+	return filepath.Join(home, ".aws", "credentials")
+}
+
+func (p *SharedFileProvider) profile() string {
+	if p.Profile != "" {
+		return p.Profile
+	}
+	if profile := os.Getenv("AWS_PROFILE"); profile != "" {
+		return profile
+	}
+	return DefaultProfile
+}
+
+// Retrieve reads credentials of the profile from the file
+func (p *SharedFileProvider) Retrieve(ctx context.Context) (Value, error) {
+	filename, profile := p.filename(), p.profile()
+	sections, err := readINI(filename)
+	if err != nil {
+		return Value{}, err
+	}
+	section, ok := sections[profile]
+	if !ok {
+		return Value{}, fmt.Errorf("Profile %v is not found in %v", profile, filename)
+	}
+	v := Value{
+		AccessKeyID:     section["aws_access_key_id"],
+		SecretAccessKey: section["aws_secret_access_key"],
+		SessionToken:    section["aws_session_token"],
+		Source:          "SharedFileProvider",
+	}
+	if err := v.validate(); err != nil {
+		return Value{}, fmt.Errorf("Profile %v in %v: %v", profile, filename, err)
+	}
+	return v, nil
+}
+
+// readINI returns keys and values of sections in the ini file. Section [profile name] is stored as name
+func readINI(filename string) (map[string]map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	sections := map[string]map[string]string{}
+	var section map[string]string
+	scanner := bufio.NewScanner(f)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("%v:%d: invalid section %v", filename, lineno, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if fields := strings.Fields(name); len(fields) == 2 && fields[0] == "profile" {
+				name = fields[1]
+			}
+			if sections[name] == nil {
+				sections[name] = map[string]string{}
+			}
+			section = sections[name]
+			continue
+		}
+		i := strings.Index(line, "=")
+		if i < 0 || section == nil {
+			return nil, fmt.Errorf("%v:%d: invalid line %v", filename, lineno, line)
+		}
+		section[strings.ToLower(strings.TrimSpace(line[:i]))] = strings.TrimSpace(line[i+1:])
+	}
+	return sections, scanner.Err()
+}
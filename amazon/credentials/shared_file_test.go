@@ -0,0 +1,86 @@
+package credentials
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const sharedCredentialsFile = `# shared credentials
+[default]
+aws_access_key_id = AK
+aws_secret_access_key = SK
+
+; temporary
+[profile affiliate]
+AWS_ACCESS_KEY_ID=AK2
+aws_secret_access_key = SK2
+aws_session_token = TOKEN
+
+[incomplete]
+aws_access_key_id = AK3
+`
+
+func TestSharedFileProvider(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazon-credentials")
+	defer os.RemoveAll(dir)
+	defer setenv(map[string]string{"AWS_PROFILE": ""})()
+	filename := filepath.Join(dir, "credentials")
+	ioutil.WriteFile(filename, []byte(sharedCredentialsFile), 0600)
+
+	v, err := (&SharedFileProvider{Filename: filename}).Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{Value{AccessKeyID: "AK", SecretAccessKey: "SK", Source: "SharedFileProvider"}, v}.DeepEqual(t)
+
+	v, err = (&SharedFileProvider{Filename: filename, Profile: "affiliate"}).Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{Value{AccessKeyID: "AK2", SecretAccessKey: "SK2", SessionToken: "TOKEN", Source: "SharedFileProvider"}, v}.DeepEqual(t)
+
+	_, err = (&SharedFileProvider{Filename: filename, Profile: "incomplete"}).Retrieve(context.Background())
+	Test{"Profile incomplete in " + filename + ": SecretAccessKey is not specified", err.Error()}.Compare(t)
+
+	_, err = (&SharedFileProvider{Filename: filename, Profile: "missing"}).Retrieve(context.Background())
+	Test{"Profile missing is not found in " + filename, err.Error()}.Compare(t)
+
+	ioutil.WriteFile(filename, []byte("[default]\naws_access_key_id = AK4\naws_secret_access_key = SK4\n"), 0600)
+	v, _ = (&SharedFileProvider{Filename: filename}).Retrieve(context.Background())
+	Test{"AK4", v.AccessKeyID}.Compare(t)
+}
+
+func TestSharedFileProviderEnvironment(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazon-credentials")
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "credentials")
+	ioutil.WriteFile(filename, []byte(sharedCredentialsFile), 0600)
+	defer setenv(map[string]string{"AWS_SHARED_CREDENTIALS_FILE": filename, "AWS_PROFILE": "affiliate", "HOME": dir})()
+	v, err := (&SharedFileProvider{}).Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{"AK2", v.AccessKeyID}.Compare(t)
+
+	os.MkdirAll(filepath.Join(dir, ".aws"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, ".aws", "credentials"), []byte("[default]\naws_access_key_id=AK5\naws_secret_access_key=SK5\n"), 0600)
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "")
+	os.Setenv("AWS_PROFILE", "")
+	v, err = (&SharedFileProvider{}).Retrieve(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{"AK5", v.AccessKeyID}.Compare(t)
+}
+
+func TestSharedFileProviderInvalid(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "amazon-credentials")
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "credentials")
+
+	ioutil.WriteFile(filename, []byte("aws_access_key_id = AK\n"), 0600)
+	_, err := (&SharedFileProvider{Filename: filename}).Retrieve(context.Background())
+	Test{filename + ":1: invalid line aws_access_key_id = AK", err.Error()}.Compare(t)
+
+	ioutil.WriteFile(filename, []byte("# comment\n[default\n"), 0600)
+	_, err = (&SharedFileProvider{Filename: filename}).Retrieve(context.Background())
+	Test{filename + ":2: invalid section [default", err.Error()}.Compare(t)
+
+	_, err = (&SharedFileProvider{Filename: filepath.Join(dir, "missing")}).Retrieve(context.Background())
+	Test{true, os.IsNotExist(err)}.Compare(t)
+}
@@ -3,6 +3,8 @@ package amazon
 import (
 	"encoding/xml"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // ErrorCode error code http://docs.aws.amazon.com/AWSECommerceService/latest/DG/ErrorMessages.html
@@ -91,6 +93,13 @@ type Errors struct {
 type Error struct {
 	Code    ErrorCode
 	Message string
+	// ItemID is the requested ItemId that the error concerns, if the message identifies one
+	ItemID string `xml:"-"`
+}
+
+// Error returns error code as string, to be used as target of errors.Is
+func (c ErrorCode) Error() string {
+	return string(c)
 }
 
 func (e Error) Error() string {
@@ -100,18 +109,149 @@ func (e Error) Error() string {
 	return ""
 }
 
-// Error returns error string
+// Is reports whether the error matches target ErrorCode or Error.
+// Empty Message or ItemID in target Error matches any value
+func (e Error) Is(target error) bool {
+	switch t := target.(type) {
+	case ErrorCode:
+		return e.Code == t
+	case Error:
+		return e.Code == t.Code &&
+			(t.Message == "" || e.Message == t.Message) &&
+			(t.ItemID == "" || e.ItemID == t.ItemID)
+	}
+	return false
+}
+
+// Error returns error string joining all errors
 func (e Errors) Error() string {
-	if len(e.ErrorNode) > 0 {
-		return e.ErrorNode[0].Error()
+	messages := make([]string, 0, len(e.ErrorNode))
+	for _, node := range e.ErrorNode {
+		if msg := node.Error(); msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns all errors to be inspected with errors.Is and errors.As
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e.ErrorNode))
+	for i, node := range e.ErrorNode {
+		errs[i] = node
+	}
+	return errs
+}
+
+// Codes returns error codes of all errors
+func (e Errors) Codes() []ErrorCode {
+	codes := make([]ErrorCode, len(e.ErrorNode))
+	for i, node := range e.ErrorNode {
+		codes[i] = node.Code
+	}
+	return codes
+}
+
+// HasCode returns whether any error has the code
+func (e Errors) HasCode(code ErrorCode) bool {
+	return len(e.FindByCode(code)) > 0
+}
+
+// FindByCode returns errors with the code
+func (e Errors) FindByCode(code ErrorCode) []Error {
+	var found []Error
+	for _, node := range e.ErrorNode {
+		if node.Code == code {
+			found = append(found, node)
+		}
+	}
+	return found
+}
+
+// ByItemID returns errors grouped by ItemID they concern. Errors not concerning any item are omitted
+func (e Errors) ByItemID() map[string][]Error {
+	found := map[string][]Error{}
+	for _, node := range e.ErrorNode {
+		if node.ItemID != "" {
+			found[node.ItemID] = append(found[node.ItemID], node)
+		}
+	}
+	return found
+}
+
+// ResolveItemIDs sets ItemID of each error whose message contains one of itemIDs.
+// ItemLookup and SimilarityLookup resolve ItemIDs of the errors with the requested ItemIds
+func (e *Errors) ResolveItemIDs(itemIDs []string) {
+	if e == nil {
+		return
+	}
+	for i := range e.ErrorNode {
+		for _, id := range itemIDs {
+			if containsItemID(e.ErrorNode[i].Message, id) {
+				e.ErrorNode[i].ItemID = id
+				break
+			}
+		}
 	}
-	return ""
+}
+
+func containsItemID(message string, itemID string) bool {
+	if itemID == "" {
+		return false
+	}
+	for offset := 0; ; {
+		i := strings.Index(message[offset:], itemID)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(itemID)
+		if !isItemIDChar(message, start-1) && !isItemIDChar(message, end) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func isItemIDChar(s string, i int) bool {
+	if i < 0 || i >= len(s) {
+		return false
+	}
+	c := s[i]
+	return c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c == '-'
+}
+
+// maxHTTPErrorBodySize is maximum length of Body kept in HTTPError
+const maxHTTPErrorBodySize = 1024
+
+// HTTPError represents non-2xx HTTP response whose body is not an error response of the API,
+// such as HTML error page on service outages
+type HTTPError struct {
+	StatusCode int
+	Header     http.Header
+	// Body is the response body truncated to 1024 bytes
+	Body []byte
+}
+
+func newHTTPError(res *http.Response, data []byte) *HTTPError {
+	if len(data) > maxHTTPErrorBodySize {
+		data = data[:maxHTTPErrorBodySize]
+	}
+	return &HTTPError{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+	}
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %v %v", e.StatusCode, http.StatusText(e.StatusCode))
 }
 
 // ErrorResponse represents error response from the API
 type ErrorResponse interface {
 	error
-	Code() string
+	Code() ErrorCode
 	Message() string
 }
 
@@ -130,6 +270,11 @@ func (e errorResponseNode) Error() string {
 	return ""
 }
 
+// Unwrap returns Error to be inspected with errors.Is and errors.As
+func (e errorResponseNode) Unwrap() error {
+	return e.ErrorNode
+}
+
 type errorResponseNode struct {
 	ErrorNode Error  `xml:"Error"`
 	RequestID string `xml:"RequestId"`
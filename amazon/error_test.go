@@ -1,6 +1,10 @@
 package amazon
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+)
 
 func TestError(t *testing.T) {
 	Test{"", Error{}.Error()}.Compare(t)
@@ -10,4 +14,112 @@ func TestError(t *testing.T) {
 func TestErrors(t *testing.T) {
 	Test{"", Errors{ErrorNode: []Error{}}.Error()}.Compare(t)
 	Test{"Error foo: bar", Errors{ErrorNode: []Error{{Code: "foo", Message: "bar"}}}.Error()}.Compare(t)
+	Test{"Error foo: bar; Error baz: qux", Errors{ErrorNode: []Error{{Code: "foo", Message: "bar"}, {Code: "baz", Message: "qux"}}}.Error()}.Compare(t)
+}
+
+func TestErrorsIsAs(t *testing.T) {
+	var err error = &Errors{ErrorNode: []Error{
+		{Code: InvalidParameterValue, Message: "foo is not a valid value for ItemId.", ItemID: "foo"},
+		{Code: ItemNotAccessible, Message: "bar is not accessible.", ItemID: "bar"},
+	}}
+	for _, test := range []Test{
+		{true, errors.Is(err, InvalidParameterValue)},
+		{true, errors.Is(err, ItemNotAccessible)},
+		{false, errors.Is(err, RequestThrottled)},
+		{true, errors.Is(err, Error{Code: ItemNotAccessible, ItemID: "bar"})},
+		{false, errors.Is(err, Error{Code: ItemNotAccessible, ItemID: "foo"})},
+	} {
+		test.Compare(t)
+	}
+	var node Error
+	if !errors.As(err, &node) {
+		t.Fatalf("Expected Error but got %v", err)
+	}
+	Test{InvalidParameterValue, node.Code}.Compare(t)
+	var res ErrorResponse
+	if !errors.As(error(itemSearchErrorResponse{errorResponseNode: errorResponseNode{ErrorNode: Error{Code: RequestThrottled}}}), &res) {
+		t.Fatal("Expected ErrorResponse")
+	}
+	Test{RequestThrottled, res.Code()}.Compare(t)
+	Test{true, errors.Is(res, RequestThrottled)}.Compare(t)
+}
+
+func TestErrorsHelpers(t *testing.T) {
+	e := Errors{ErrorNode: []Error{
+		{Code: InvalidParameterValue, Message: "foo", ItemID: "foo"},
+		{Code: InvalidParameterValue, Message: "bar", ItemID: "bar"},
+		{Code: ItemNotAccessible, Message: "bar is not accessible", ItemID: "bar"},
+		{Code: MissingParameters, Message: "AssociateTag"},
+	}}
+	for _, test := range []Test{
+		{[]ErrorCode{InvalidParameterValue, InvalidParameterValue, ItemNotAccessible, MissingParameters}, e.Codes()},
+		{[]Error{e.ErrorNode[0], e.ErrorNode[1]}, e.FindByCode(InvalidParameterValue)},
+		{[]Error(nil), e.FindByCode(RequestThrottled)},
+		{map[string][]Error{
+			"foo": {e.ErrorNode[0]},
+			"bar": {e.ErrorNode[1], e.ErrorNode[2]},
+		}, e.ByItemID()},
+	} {
+		test.DeepEqual(t)
+	}
+	Test{true, e.HasCode(MissingParameters)}.Compare(t)
+	Test{false, e.HasCode(RequestThrottled)}.Compare(t)
+}
+
+func TestErrorsResolveItemIDs(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{200, `<ItemLookupResponse><Items><Request><IsValid>True</IsValid><Errors>` +
+			`<Error><Code>AWS.InvalidParameterValue</Code><Message>B00000001 is not a valid value for ItemId. Please change this value and retry your request.</Message></Error>` +
+			`<Error><Code>AWS.ECommerceService.ItemNotAccessible</Code><Message>The ItemID B0000000 is not accessible through the Product Advertising API.</Message></Error>` +
+			`<Error><Code>AWS.InternalError</Code><Message>oops</Message></Error>` +
+			`</Errors></Request></Items></ItemLookupResponse>`},
+	}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	_, err := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"B0000000", "B00000001", "B00000002"}}).Do()
+	e, ok := err.(*Errors)
+	if !ok {
+		t.Fatalf("Expected *Errors but got %v", err)
+	}
+	for _, test := range []Test{
+		{"B00000001", e.ErrorNode[0].ItemID},
+		{"B0000000", e.ErrorNode[1].ItemID},
+		{"", e.ErrorNode[2].ItemID},
+	} {
+		test.Compare(t)
+	}
+}
+
+func TestHTTPError(t *testing.T) {
+	body := "<html><body>" + strings.Repeat("Service Unavailable ", 100) + "</body></html>"
+	doer := &sequenceDoer{replies: []mockReply{{503, body}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	res, err := client.DoRequest(&mockOperation{}, &mockResponse{})
+	if res != nil {
+		t.Errorf("Expected nil but got %v", res)
+	}
+	httpErr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("Expected *HTTPError but got %v", err)
+	}
+	Test{"HTTP 503 Service Unavailable", httpErr.Error()}.Compare(t)
+	Test{503, httpErr.StatusCode}.Compare(t)
+	Test{1024, len(httpErr.Body)}.Compare(t)
+	Test{body[:1024], string(httpErr.Body)}.Compare(t)
+}
+
+func TestHTTPErrorWithErrorResponse(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{{503, throttledErrorResponse}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	_, err := client.DoRequest(&mockOperation{}, &mockResponse{})
+	if _, ok := err.(*HTTPError); ok {
+		t.Errorf("Expected error response but got %v", err)
+	}
+	Test{RequestThrottled, err.(itemSearchErrorResponse).Code()}.Compare(t)
+}
+
+func TestHTTPErrorWithValidBody(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{{500, "<mock><result>OK</result></mock>"}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	_, err := client.DoRequest(&mockOperation{}, &mockResponse{})
+	Test{"HTTP 500 Internal Server Error", err.Error()}.Compare(t)
 }
@@ -17,21 +17,42 @@ type Items struct {
 
 // Item represents item
 type Item struct {
-	XMLName         xml.Name `xml:"Item"`
-	ASIN            string
-	DetailPageURL   string
-	SalesRank       int
-	ItemLinks       ItemLinks
-	SmallImage      Image
-	MediumImage     Image
-	LargeImage      Image
-	ImageSets       ImageSets
-	ItemAttributes  ItemAttributes
-	OfferSummary    OfferSummary
-	Offers          Offers
-	CustomerReviews CustomerReviews
-	SimilarProducts SimilarProducts
-	BrowseNodes     BrowseNodes
+	XMLName          xml.Name `xml:"Item"`
+	ASIN             string
+	ParentASIN       string
+	DetailPageURL    string
+	SalesRank        int
+	ItemLinks        ItemLinks
+	SmallImage       Image
+	MediumImage      Image
+	LargeImage       Image
+	ImageSets        ImageSets
+	ItemAttributes   ItemAttributes
+	OfferSummary     OfferSummary
+	Offers           Offers
+	CustomerReviews  CustomerReviews
+	EditorialReviews EditorialReviews
+	SimilarProducts  SimilarProducts
+	BrowseNodes      BrowseNodes
+	Tracks           Tracks
+	Subjects         Subjects
+	// VariationAttributes are dimension values of the child item such as Color and Size
+	VariationAttributes VariationAttributes
+	VariationSummary    VariationSummary
+	// Variations are child items of the parent item in the VariationPage
+	Variations Variations
+	// RelatedItems are items related by RelationshipType in the RelatedItemPage
+	RelatedItems []RelatedItems
+}
+
+// RelatedItemsOf returns RelatedItems of the relationship type, or nil if not found
+func (item *Item) RelatedItemsOf(relationshipType RelationshipType) *RelatedItems {
+	for i := range item.RelatedItems {
+		if item.RelatedItems[i].RelationshipType == relationshipType {
+			return &item.RelatedItems[i]
+		}
+	}
+	return nil
 }
 
 // ItemLinks represents ItemLinks
@@ -99,38 +120,93 @@ func (c *Date) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	return fmt.Errorf("Invalid date %v", v)
 }
 
+// MarshalXML encodes date with yyyy-mm-dd format
+func (c Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(c.Format("2006-01-02"), start)
+}
+
 // ItemAttributes represents ItemAttributes
+// http://docs.aws.amazon.com/AWSECommerceService/latest/DG/RG_ItemAttributes.html
 type ItemAttributes struct {
-	Author            []string
-	Artist            string
-	Actor             string
-	AspectRatio       string
-	AudienceRating    string
-	Binding           string
-	Creator           Creator
-	EAN               string
-	EANList           EANList
-	CatalogNumberList CatalogNumberList
-	Format            []string
-	IsAdultProduct    bool
-	ISBN              string
-	Label             string
-	Languages         Languages
-	ListPrice         Price
-	Manufacturer      string
-	NumberOfPages     int
-	PackageDimensions PackageDimensions
-	ProductGroup      string
-	ProductTypeName   string
-	PublicationDate   *Date
-	PackageQuantity   int
-	PartNumber        string
-	UPC               string
-	UPCList           UPCList
-	Publisher         string
-	Studio            string
-	Title             string
-	NumberOfDiscs     []int
+	Actor                                []string
+	Artist                               []string
+	AspectRatio                          string
+	AudienceRating                       string
+	AudioFormat                          []string
+	Author                               []string
+	Binding                              string
+	Brand                                string
+	CatalogNumberList                    CatalogNumberList
+	Category                             []string
+	CEROAgeRating                        string
+	ClothingSize                         string
+	Color                                string
+	Creator                              []Creator
+	Department                           string
+	Director                             []string
+	EAN                                  string
+	EANList                              EANList
+	Edition                              string
+	EISBN                                []string
+	EpisodeSequence                      string
+	ESRBAgeRating                        string
+	Feature                              []string
+	Format                               []string
+	Genre                                string
+	HardwareType                         string
+	HazardousMaterialType                string
+	IsAdultProduct                       bool
+	IsAutographed                        bool
+	ISBN                                 string
+	IsEligibleForTradeIn                 bool
+	IsMemorabilia                        bool
+	IssuesPerYear                        string
+	ItemDimensions                       ItemDimensions
+	ItemPartNumber                       string
+	Label                                string
+	Languages                            Languages
+	LegalDisclaimer                      string
+	ListPrice                            Price
+	MagazineType                         string
+	Manufacturer                         string
+	ManufacturerMaximumAge               DecimalWithUnits
+	ManufacturerMinimumAge               DecimalWithUnits
+	ManufacturerPartsWarrantyDescription string
+	MediaType                            string
+	Model                                string
+	ModelYear                            int
+	MPN                                  string
+	NumberOfDiscs                        int
+	NumberOfIssues                       int
+	NumberOfItems                        int
+	NumberOfPages                        int
+	NumberOfTracks                       int
+	OperatingSystem                      string
+	PackageDimensions                    PackageDimensions
+	PackageQuantity                      int
+	PartNumber                           string
+	PictureFormat                        []string
+	Platform                             []string
+	ProductGroup                         string
+	ProductTypeName                      string
+	ProductTypeSubcategory               string
+	PublicationDate                      *Date
+	Publisher                            string
+	RegionCode                           string
+	ReleaseDate                          *Date
+	RunningTime                          Size
+	SeikodoProductCode                   string
+	ShoeSize                             string
+	Size                                 string
+	SKU                                  string
+	Studio                               string
+	SubscriptionLength                   Size
+	Title                                string
+	TrackSequence                        string
+	TradeInValue                         Price
+	UPC                                  string
+	UPCList                              UPCList
+	Warranty                             string
 }
 
 // Creator represents Creator
@@ -158,9 +234,26 @@ type Language struct {
 
 // Price represents Price
 type Price struct {
+	// Amount is raw amount in the minor unit of CurrencyCode
 	Amount         string
 	CurrencyCode   string
 	FormattedPrice string
+	// Money is Amount in CurrencyCode parsed on unmarshalling, zero if Amount is malformed
+	Money Money `xml:"-"`
+}
+
+// ItemDimensions represents ItemDimensions
+type ItemDimensions struct {
+	Height Size
+	Length Size
+	Weight Size
+	Width  Size
+}
+
+// DecimalWithUnits represents decimal value with units such as ManufacturerMinimumAge in months
+type DecimalWithUnits struct {
+	Value float64 `xml:",chardata"`
+	Units string  `xml:",attr"`
 }
 
 // PackageDimensions represents PackageDimensions
@@ -181,6 +274,109 @@ type OfferSummary struct {
 	TotalRefurbished int
 }
 
+// EditorialReviews represents EditorialReviews
+type EditorialReviews struct {
+	EditorialReview []EditorialReview
+}
+
+// EditorialReview represents EditorialReview
+type EditorialReview struct {
+	Source string
+	// Content is HTML of the review
+	Content string
+	// IsLinkSuppressed is whether links in Content must not be displayed
+	IsLinkSuppressed bool
+}
+
+// Tracks represents Tracks
+type Tracks struct {
+	Disc []Disc
+}
+
+// Disc represents Disc
+type Disc struct {
+	Number int `xml:",attr"`
+	Track  []Track
+}
+
+// Track represents Track
+type Track struct {
+	Number int    `xml:",attr"`
+	Title  string `xml:",chardata"`
+}
+
+// Subjects represents Subjects
+type Subjects struct {
+	Subject []string
+}
+
+// VariationAttributes represents VariationAttributes
+type VariationAttributes struct {
+	VariationAttribute []VariationAttribute
+}
+
+// Value returns value of the dimension such as Color, or empty string if not found
+func (attrs VariationAttributes) Value(name string) string {
+	for _, attr := range attrs.VariationAttribute {
+		if attr.Name == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// VariationAttribute represents VariationAttribute
+type VariationAttribute struct {
+	Name  string
+	Value string
+}
+
+// VariationSummary represents VariationSummary
+type VariationSummary struct {
+	LowestPrice      Price
+	HighestPrice     Price
+	LowestSalePrice  Price
+	HighestSalePrice Price
+}
+
+// Variations represents Variations
+type Variations struct {
+	TotalVariations     int
+	TotalVariationPages int
+	VariationDimensions VariationDimensions
+	Item                []Item
+}
+
+// VariationDimensions represents VariationDimensions
+type VariationDimensions struct {
+	VariationDimension []string
+}
+
+// Relationship represents direction of RelatedItems
+type Relationship string
+
+const (
+	// RelationshipParents means the related items are parents of the item such as Season of an Episode
+	RelationshipParents Relationship = "Parents"
+	// RelationshipChildren means the related items are children of the item such as Episodes of a Season
+	RelationshipChildren Relationship = "Children"
+)
+
+// RelatedItems represents RelatedItems
+type RelatedItems struct {
+	Relationship         Relationship
+	RelationshipType     RelationshipType
+	RelatedItemCount     int
+	RelatedItemPageCount int
+	RelatedItemPage      int
+	RelatedItem          []RelatedItem
+}
+
+// RelatedItem represents RelatedItem
+type RelatedItem struct {
+	Item Item
+}
+
 // Offers represents Offers
 type Offers struct {
 	TotalOffers     int
@@ -1,6 +1,7 @@
 package amazon
 
 import (
+	"context"
 	"encoding/xml"
 	"strings"
 )
@@ -47,6 +48,12 @@ const (
 	ItemLookupResponseGroupTracks ItemLookupResponseGroup = "Tracks"
 	// ItemLookupResponseGroupVariationImages is a constant for VariationImages response group
 	ItemLookupResponseGroupVariationImages ItemLookupResponseGroup = "VariationImages"
+	// ItemLookupResponseGroupVariationMatrix is a constant for VariationMatrix response group
+	ItemLookupResponseGroupVariationMatrix ItemLookupResponseGroup = "VariationMatrix"
+	// ItemLookupResponseGroupVariationMinimum is a constant for VariationMinimum response group
+	ItemLookupResponseGroupVariationMinimum ItemLookupResponseGroup = "VariationMinimum"
+	// ItemLookupResponseGroupVariationOffers is a constant for VariationOffers response group
+	ItemLookupResponseGroupVariationOffers ItemLookupResponseGroup = "VariationOffers"
 	// ItemLookupResponseGroupVariations is a constant for Variations response group
 	ItemLookupResponseGroupVariations ItemLookupResponseGroup = "Variations"
 	// ItemLookupResponseGroupVariationSummary is a constant for VariationSummary response group
@@ -66,6 +73,8 @@ type ItemLookupParameters struct {
 	SearchIndex           SearchIndex
 	TruncateReviewsAt     *int
 	VariationPage         int
+	// AssociateTag overrides associate tag of Client for the request if not empty
+	AssociateTag string
 }
 
 // ItemLookupRequest represents request for ItemLookup operation
@@ -118,6 +127,9 @@ func (req *ItemLookupRequest) Query() map[string]interface{} {
 		q["VariationPage"] = req.Parameters.VariationPage
 	}
 	q["ResponseGroup"] = req.Parameters.ResponseGroups
+	if req.Parameters.AssociateTag != "" {
+		q["AssociateTag"] = req.Parameters.AssociateTag
+	}
 	return q
 }
 
@@ -131,10 +143,16 @@ func (req *ItemLookupRequest) operation() string {
 
 // Do sends request for the API
 func (req *ItemLookupRequest) Do() (*ItemLookupResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *ItemLookupRequest) DoContext(ctx context.Context) (*ItemLookupResponse, error) {
 	respObj := ItemLookupResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
+	respObj.Items.Request.Errors.ResolveItemIDs(req.Parameters.ItemIDs)
 	if err := respObj.Error(); err != nil {
 		return nil, err
 	}
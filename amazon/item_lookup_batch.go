@@ -0,0 +1,115 @@
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MaxItemLookupItemIDs is maximum number of ItemIds in a single ItemLookup request
+const MaxItemLookupItemIDs = 10
+
+// ItemLookupChunkError represents error of ItemLookup request for a chunk of ItemIds
+type ItemLookupChunkError struct {
+	ItemIDs []string
+	Err     error
+}
+
+func (e *ItemLookupChunkError) Error() string {
+	return fmt.Sprintf("ItemLookup for %v failed: %v", strings.Join(e.ItemIDs, ","), e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ItemLookupChunkError) Unwrap() error {
+	return e.Err
+}
+
+// ItemLookupBatchResult represents merged result of ItemLookup requests for chunks of ItemIds
+type ItemLookupBatchResult struct {
+	// Results are results for each requested ItemId, in the requested order.
+	// Status of ItemIds in failed chunks is ItemLookupStatusError
+	Results []ItemLookupItemResult
+	// Errors are errors of failed chunks, in the requested order
+	Errors []*ItemLookupChunkError
+}
+
+// Items returns all found items in the requested order
+func (result *ItemLookupBatchResult) Items() []Item {
+	var items []Item
+	for _, r := range result.Results {
+		if r.Item != nil {
+			items = append(items, *r.Item)
+		}
+	}
+	return items
+}
+
+// Err returns the first chunk error, or nil if all chunks succeeded
+func (result *ItemLookupBatchResult) Err() error {
+	if len(result.Errors) > 0 {
+		return result.Errors[0]
+	}
+	return nil
+}
+
+// WithMaxConcurrency sets maximum number of requests sent concurrently by batch operations
+func WithMaxConcurrency(n int) Option {
+	return func(client *Client) error {
+		client.MaxConcurrency = n
+		return nil
+	}
+}
+
+func (client *Client) concurrency() int {
+	if client.MaxConcurrency > 0 {
+		return client.MaxConcurrency
+	}
+	return 1
+}
+
+// ItemLookupBatch looks up any number of ItemIDs in parameters, splitting them into chunks of MaxItemLookupItemIDs.
+// Chunks are sent concurrently up to MaxConcurrency, passing through RateLimiter of the client
+func (client *Client) ItemLookupBatch(ctx context.Context, parameters ItemLookupParameters) *ItemLookupBatchResult {
+	ids := parameters.ItemIDs
+	result := &ItemLookupBatchResult{
+		Results: make([]ItemLookupItemResult, len(ids)),
+	}
+	nchunks := (len(ids) + MaxItemLookupItemIDs - 1) / MaxItemLookupItemIDs
+	chunkErrors := make([]*ItemLookupChunkError, nchunks)
+	sem := make(chan struct{}, client.concurrency())
+	var wg sync.WaitGroup
+	for i := 0; i < nchunks; i++ {
+		start := i * MaxItemLookupItemIDs
+		end := start + MaxItemLookupItemIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		wg.Add(1)
+		go func(i, start, end int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			p := parameters
+			p.ItemIDs = ids[start:end]
+			res, err := client.ItemLookup(p).DoPartialContext(ctx)
+			if err != nil {
+				chunkErrors[i] = &ItemLookupChunkError{ItemIDs: p.ItemIDs, Err: err}
+			}
+			if res == nil {
+				for j, id := range p.ItemIDs {
+					result.Results[start+j] = ItemLookupItemResult{ItemID: id, Status: ItemLookupStatusError}
+				}
+				return
+			}
+			copy(result.Results[start:end], res.Results)
+		}(i, start, end)
+	}
+	wg.Wait()
+	for _, e := range chunkErrors {
+		if e != nil {
+			result.Errors = append(result.Errors, e)
+		}
+	}
+	return result
+}
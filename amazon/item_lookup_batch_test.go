@@ -0,0 +1,97 @@
+package amazon
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+// runningTransport counts requests running concurrently through http.DefaultTransport mocked by gock
+type runningTransport struct {
+	mu         sync.Mutex
+	running    int
+	maxRunning int
+}
+
+func (transport *runningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport.mu.Lock()
+	transport.running++
+	if transport.running > transport.maxRunning {
+		transport.maxRunning = transport.running
+	}
+	transport.mu.Unlock()
+	defer func() {
+		transport.mu.Lock()
+		transport.running--
+		transport.mu.Unlock()
+	}()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestItemLookupBatch(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	found := []string{"477418392X", "B01FH3KRTI", "4621300253", "4873117526", "4865940413", "4863541783", "4798031801", "4774184322", "4863541171", "B01LDFK76M"}
+	failed := []string{"0134190440", "B000BTL0OA", "B00005JNOG", "B01MY7GHKJ", "B01M0EKQR2", "B001L5U3Y0", "B00ZV9RDKK", "B000096OT9", "B00005N5PF", "B00LMR1RUG"}
+	rest := []string{"4621300253", "UNKNOWN"}
+	gock.New("https://webservices.amazon.co.jp").
+		Get("/onca/xml").
+		MatchParam("ItemId", "^"+strings.Join(found, ",")+"$").
+		Reply(200).
+		Delay(20 * time.Millisecond).
+		File("_fixtures/ItemLookup.xml")
+	gock.New("https://webservices.amazon.co.jp").
+		Get("/onca/xml").
+		MatchParam("ItemId", "^"+strings.Join(failed, ",")+"$").
+		Reply(503).
+		Delay(20 * time.Millisecond).
+		BodyString("<html>Service Unavailable</html>")
+	gock.New("https://webservices.amazon.co.jp").
+		Get("/onca/xml").
+		MatchParam("ItemId", "^"+strings.Join(rest, ",")+"$").
+		Reply(200).
+		Delay(20 * time.Millisecond).
+		File("_fixtures/ItemLookup.xml")
+	transport := &runningTransport{}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithTransport(transport), WithMaxConcurrency(2), WithRateLimiter(nil))
+	ids := append(append(append([]string{}, found...), failed...), rest...)
+	result := client.ItemLookupBatch(context.Background(), ItemLookupParameters{ItemIDs: ids})
+	Test{true, gock.IsDone()}.Compare(t)
+	Test{2, transport.maxRunning}.Compare(t)
+	Test{22, len(result.Results)}.Compare(t)
+	for i, r := range result.Results {
+		Test{ids[i], r.ItemID}.Compare(t)
+		switch {
+		case i >= 10 && i < 20:
+			Test{ItemLookupStatusError, r.Status}.Compare(t)
+		case i == 21:
+			Test{ItemLookupStatusNotFound, r.Status}.Compare(t)
+		default:
+			Test{ItemLookupStatusFound, r.Status}.Compare(t)
+			Test{ids[i], r.Item.ASIN}.Compare(t)
+		}
+	}
+	Test{11, len(result.Items())}.Compare(t)
+	Test{"477418392X", result.Items()[0].ASIN}.Compare(t)
+	Test{"4621300253", result.Items()[10].ASIN}.Compare(t)
+	Test{1, len(result.Errors)}.Compare(t)
+	Test{failed, result.Errors[0].ItemIDs}.DeepEqual(t)
+	Test{"ItemLookup for " + strings.Join(failed, ",") + " failed: HTTP 503 Service Unavailable", result.Err().Error()}.Compare(t)
+	if _, ok := result.Errors[0].Unwrap().(*HTTPError); !ok {
+		t.Errorf("Expected *HTTPError but got %v", result.Errors[0].Unwrap())
+	}
+}
+
+func TestItemLookupBatchEmpty(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	result := client.ItemLookupBatch(context.Background(), ItemLookupParameters{})
+	Test{0, len(result.Results)}.Compare(t)
+	Test{nil, result.Err()}.Compare(t)
+}
@@ -0,0 +1,124 @@
+package amazon
+
+import (
+	"context"
+	"errors"
+)
+
+// RelatedItemIterator walks items related to an item of ItemLookup by RelationshipType related item page by page,
+// such as all Episodes of a Season. Pages are requested lazily, up to RelatedItemPageCount
+//
+//	it := client.ItemLookup(amazon.ItemLookupParameters{
+//		ItemIDs:          []string{seasonASIN},
+//		RelationshipType: amazon.RelationshipTypeEpisode,
+//	}).RelatedItemIterator(ctx)
+//	for it.Next() {
+//		episode := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type RelatedItemIterator struct {
+	walker   pageWalker
+	req      ItemLookupRequest
+	response *ItemLookupResponse
+}
+
+// RelatedItemIterator returns new RelatedItemIterator of the item in ItemIDs starting from RelatedItemPage in parameters.
+// RelatedItems response group is added if not specified
+func (req *ItemLookupRequest) RelatedItemIterator(ctx context.Context) *RelatedItemIterator {
+	it := &RelatedItemIterator{req: *req}
+	it.walker = pageWalker{
+		ctx:   ctx,
+		start: req.Parameters.RelatedItemPage,
+		fetch: it.fetch,
+	}
+	if len(req.Parameters.ItemIDs) != 1 {
+		it.walker.err = errors.New("RelatedItemIterator requires exactly one ItemID")
+	} else if req.Parameters.RelationshipType == "" {
+		it.walker.err = errors.New("RelationshipType is not specified")
+	}
+	groups := append([]ItemLookupResponseGroup{}, req.Parameters.ResponseGroups...)
+	found := false
+	for _, group := range groups {
+		if group == ItemLookupResponseGroupRelatedItems {
+			found = true
+		}
+	}
+	if !found {
+		groups = append(groups, ItemLookupResponseGroupRelatedItems)
+	}
+	it.req.Parameters.ResponseGroups = groups
+	return it
+}
+
+// Next advances to the next related item, requesting the next related item page if needed.
+// It returns false when there are no more items or an error occurred
+func (it *RelatedItemIterator) Next() bool {
+	return it.walker.next()
+}
+
+func (it *RelatedItemIterator) fetch(ctx context.Context, page int) (int, int, error) {
+	req := it.req
+	req.Parameters.RelatedItemPage = page
+	res, err := req.DoContext(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	it.response = res
+	if related := it.RelatedItems(); related != nil {
+		return len(related.RelatedItem), related.RelatedItemPageCount, nil
+	}
+	return 0, 0, nil
+}
+
+// Item returns the current related item
+func (it *RelatedItemIterator) Item() Item {
+	return it.RelatedItems().RelatedItem[it.walker.index].Item
+}
+
+// Source returns the item in ItemIDs of the current page, or nil if not found
+func (it *RelatedItemIterator) Source() *Item {
+	if it.response == nil || len(it.response.Items.Item) == 0 {
+		return nil
+	}
+	return &it.response.Items.Item[0]
+}
+
+// RelatedItems returns RelatedItems of RelationshipType in the current page, or nil if not found
+func (it *RelatedItemIterator) RelatedItems() *RelatedItems {
+	if source := it.Source(); source != nil {
+		return source.RelatedItemsOf(it.req.Parameters.RelationshipType)
+	}
+	return nil
+}
+
+// Err returns the error occurred while iterating
+func (it *RelatedItemIterator) Err() error {
+	return it.walker.err
+}
+
+// Page returns the current RelatedItemPage
+func (it *RelatedItemIterator) Page() int {
+	return it.walker.page
+}
+
+// Response returns response of the current page
+func (it *RelatedItemIterator) Response() *ItemLookupResponse {
+	return it.response
+}
+
+// RelatedItemCount returns total number of related items, available after the first call of Next
+func (it *RelatedItemIterator) RelatedItemCount() int {
+	if related := it.RelatedItems(); related != nil {
+		return related.RelatedItemCount
+	}
+	return 0
+}
+
+// RelatedItemPageCount returns total number of related item pages, available after the first call of Next
+func (it *RelatedItemIterator) RelatedItemPageCount() int {
+	if related := it.RelatedItems(); related != nil {
+		return related.RelatedItemPageCount
+	}
+	return 0
+}
@@ -0,0 +1,117 @@
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func mockRelatedItemPage(page int, responseGroup, fixture string) {
+	gock.New("https://webservices.amazon.com/onca/xml").
+		MatchParams(map[string]string{
+			"Operation":        "^ItemLookup$",
+			"ItemId":           "^B00LMQZ3XK$",
+			"RelationshipType": "^Episode$",
+			"ResponseGroup":    "^" + responseGroup + "$",
+			"RelatedItemPage":  fmt.Sprintf("^%d$", page),
+		}).
+		Reply(200).
+		File("_fixtures/" + fixture)
+}
+
+func TestRelatedItemIterator(t *testing.T) {
+	for _, test := range []struct {
+		relatedItemPage      int
+		pages                map[int]string
+		asins                []string
+		relatedItemCount     int
+		relatedItemPageCount int
+		page                 int
+	}{
+		{0, map[int]string{1: "ItemLookupRelatedItemsPage1.xml", 2: "ItemLookupRelatedItemsPage2.xml"},
+			[]string{"B00LMR1RUG", "B00LMR2AHK", "B00LMR2NDW"}, 3, 2, 2},
+		{2, map[int]string{2: "ItemLookupRelatedItemsPage2.xml"},
+			[]string{"B00LMR2NDW"}, 3, 2, 2},
+		{0, map[int]string{1: "ItemLookupRelatedItems.xml"},
+			[]string{"B00LMR1RUG", "B00LMR2AHK"}, 7, 1, 1},
+	} {
+		gock.DisableNetworking()
+		for page, fixture := range test.pages {
+			mockRelatedItemPage(page, "RelatedItems", fixture)
+		}
+		client, _ := New("AK", "SK", "ngsio-20", RegionUS, WithRateLimiter(nil))
+		it := client.ItemLookup(ItemLookupParameters{
+			ItemIDs:          []string{"B00LMQZ3XK"},
+			RelationshipType: RelationshipTypeEpisode,
+			RelatedItemPage:  test.relatedItemPage,
+		}).RelatedItemIterator(context.Background())
+		Test{0, it.RelatedItemCount()}.Compare(t)
+		var asins []string
+		for it.Next() {
+			asins = append(asins, it.Item().ASIN)
+		}
+		Test{nil, it.Err()}.Compare(t)
+		Test{true, gock.IsDone()}.Compare(t)
+		Test{test.asins, asins}.DeepEqual(t)
+		Test{test.relatedItemCount, it.RelatedItemCount()}.Compare(t)
+		Test{test.relatedItemPageCount, it.RelatedItemPageCount()}.Compare(t)
+		Test{RelationshipChildren, it.RelatedItems().Relationship}.Compare(t)
+		Test{"B00LMQZ3XK", it.Source().ASIN}.Compare(t)
+		Test{test.page, it.Page()}.Compare(t)
+		gock.Off()
+	}
+}
+
+func TestRelatedItemIteratorResponseGroups(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	mockRelatedItemPage(1, "RelatedItems,Small", "ItemLookupRelatedItems.xml")
+	client, _ := New("AK", "SK", "ngsio-20", RegionUS, WithRateLimiter(nil))
+	it := client.ItemLookup(ItemLookupParameters{
+		ItemIDs:          []string{"B00LMQZ3XK"},
+		RelationshipType: RelationshipTypeEpisode,
+		ResponseGroups:   []ItemLookupResponseGroup{ItemLookupResponseGroupRelatedItems, ItemLookupResponseGroupSmall},
+	}).RelatedItemIterator(context.Background())
+	for it.Next() {
+	}
+	Test{nil, it.Err()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+	Test{"Pilot", it.RelatedItems().RelatedItem[0].Item.ItemAttributes.Title}.Compare(t)
+}
+
+func TestRelatedItemIteratorInvalid(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	gock.CleanUnmatchedRequest()
+	client, _ := New("AK", "SK", "ngsio-20", RegionUS, WithRateLimiter(nil))
+	it := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"B00LMQZ3XK"}}).RelatedItemIterator(context.Background())
+	Test{false, it.Next()}.Compare(t)
+	Test{"RelationshipType is not specified", it.Err().Error()}.Compare(t)
+	it = client.ItemLookup(ItemLookupParameters{RelationshipType: RelationshipTypeEpisode}).RelatedItemIterator(context.Background())
+	Test{false, it.Next()}.Compare(t)
+	Test{"RelatedItemIterator requires exactly one ItemID", it.Err().Error()}.Compare(t)
+	Test{false, gock.HasUnmatchedRequest()}.Compare(t)
+}
+
+func TestRelatedItemIteratorCanceled(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	mockRelatedItemPage(1, "RelatedItems", "ItemLookupRelatedItemsPage1.xml")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, _ := New("AK", "SK", "ngsio-20", RegionUS, WithRateLimiter(nil))
+	it := client.ItemLookup(ItemLookupParameters{
+		ItemIDs:          []string{"B00LMQZ3XK"},
+		RelationshipType: RelationshipTypeEpisode,
+	}).RelatedItemIterator(ctx)
+	count := 0
+	for it.Next() {
+		count++
+		cancel()
+	}
+	Test{2, count}.Compare(t)
+	Test{context.Canceled, it.Err()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+}
@@ -0,0 +1,143 @@
+package amazon
+
+import "context"
+
+// ItemLookupStatus represents status of each requested ItemId
+type ItemLookupStatus string
+
+const (
+	// ItemLookupStatusFound item is found
+	ItemLookupStatusFound ItemLookupStatus = "Found"
+	// ItemLookupStatusInvalid ItemId is not a valid value
+	ItemLookupStatusInvalid ItemLookupStatus = "Invalid"
+	// ItemLookupStatusNotAccessible item is not accessible through the API
+	ItemLookupStatusNotAccessible ItemLookupStatus = "NotAccessible"
+	// ItemLookupStatusError other error is returned for ItemId
+	ItemLookupStatusError ItemLookupStatus = "Error"
+	// ItemLookupStatusNotFound neither item nor error is returned for ItemId
+	ItemLookupStatusNotFound ItemLookupStatus = "NotFound"
+)
+
+// ItemLookupItemResult represents result for each requested ItemId
+type ItemLookupItemResult struct {
+	ItemID string
+	Status ItemLookupStatus
+	// Item is found item, nil unless Status is ItemLookupStatusFound
+	Item *Item
+	// Error is error concerning ItemID, nil if no error is returned
+	Error *Error
+}
+
+// ItemLookupResult represents partial-success result for ItemLookup operation
+type ItemLookupResult struct {
+	Response *ItemLookupResponse
+	// Results are results for each requested ItemId, in the requested order
+	Results []ItemLookupItemResult
+}
+
+// Items returns all found items
+func (result *ItemLookupResult) Items() []Item {
+	return result.Response.Items.Item
+}
+
+// Result returns result for the ItemId
+func (result *ItemLookupResult) Result(itemID string) (ItemLookupItemResult, bool) {
+	for _, r := range result.Results {
+		if r.ItemID == itemID {
+			return r, true
+		}
+	}
+	return ItemLookupItemResult{}, false
+}
+
+// ItemIDs returns requested ItemIds with the status
+func (result *ItemLookupResult) ItemIDs(status ItemLookupStatus) []string {
+	var ids []string
+	for _, r := range result.Results {
+		if r.Status == status {
+			ids = append(ids, r.ItemID)
+		}
+	}
+	return ids
+}
+
+// DoPartial sends request for the API and returns found items together with status for each requested ItemId.
+// Errors concerning particular ItemIds are reported in the result instead of being returned.
+// Errors not concerning any ItemId are returned as *Errors along with the result
+func (req *ItemLookupRequest) DoPartial() (*ItemLookupResult, error) {
+	return req.DoPartialContext(context.Background())
+}
+
+// DoPartialContext is DoPartial with the context
+func (req *ItemLookupRequest) DoPartialContext(ctx context.Context) (*ItemLookupResult, error) {
+	respObj := ItemLookupResponse{}
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
+		return nil, err
+	}
+	errs := respObj.Items.Request.Errors
+	errs.ResolveItemIDs(req.Parameters.ItemIDs)
+	result := &ItemLookupResult{
+		Response: &respObj,
+		Results:  make([]ItemLookupItemResult, len(req.Parameters.ItemIDs)),
+	}
+	for i, id := range req.Parameters.ItemIDs {
+		r := ItemLookupItemResult{ItemID: id, Status: ItemLookupStatusNotFound}
+		if item := findItemByID(respObj.Items.Item, req.Parameters.IDType, id); item != nil {
+			r.Item = item
+			r.Status = ItemLookupStatusFound
+		} else if errs != nil {
+			if found := errs.ByItemID()[id]; len(found) > 0 {
+				r.Error = &found[0]
+				r.Status = itemLookupStatusForCode(found[0].Code)
+			}
+		}
+		result.Results[i] = r
+	}
+	if errs != nil {
+		var unresolved []Error
+		for _, node := range errs.ErrorNode {
+			if node.ItemID == "" {
+				unresolved = append(unresolved, node)
+			}
+		}
+		if len(unresolved) > 0 {
+			return result, &Errors{ErrorNode: unresolved}
+		}
+	}
+	return result, nil
+}
+
+func itemLookupStatusForCode(code ErrorCode) ItemLookupStatus {
+	switch code {
+	case InvalidParameterValue:
+		return ItemLookupStatusInvalid
+	case ItemNotAccessible:
+		return ItemLookupStatusNotAccessible
+	}
+	return ItemLookupStatusError
+}
+
+func findItemByID(items []Item, idType IDType, id string) *Item {
+	for i := range items {
+		attrs := items[i].ItemAttributes
+		var candidates []string
+		switch idType {
+		case IDTypeISBN:
+			candidates = append([]string{attrs.ISBN, attrs.EAN}, attrs.EANList.Element...)
+		case IDTypeEAN:
+			candidates = append([]string{attrs.EAN}, attrs.EANList.Element...)
+		case IDTypeUPC:
+			candidates = append([]string{attrs.UPC}, attrs.UPCList.Element...)
+		case IDTypeSKU:
+			candidates = []string{attrs.SKU}
+		default:
+			candidates = []string{items[i].ASIN}
+		}
+		for _, c := range candidates {
+			if c != "" && c == id {
+				return &items[i]
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package amazon
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+const partialItemLookupResponse = `<ItemLookupResponse><Items><Request><IsValid>True</IsValid><Errors>` +
+	`<Error><Code>AWS.InvalidParameterValue</Code><Message>B000000001 is not a valid value for ItemId. Please change this value and retry your request.</Message></Error>` +
+	`<Error><Code>AWS.ECommerceService.ItemNotAccessible</Code><Message>This item B000000002 is not accessible through the Product Advertising API.</Message></Error>` +
+	`</Errors></Request>` +
+	`<Item><ASIN>4621300253</ASIN><ItemAttributes><Title>プログラミング言語Go</Title><EAN>9784621300251</EAN><ISBN>4621300253</ISBN></ItemAttributes></Item>` +
+	`<Item><ASIN>4873117526</ASIN><ItemAttributes><Title>Go言語による並行処理</Title></ItemAttributes></Item>` +
+	`</Items></ItemLookupResponse>`
+
+func TestItemLookupDoPartial(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{{200, partialItemLookupResponse}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	res, err := client.ItemLookup(ItemLookupParameters{
+		ItemIDs: []string{"4621300253", "B000000001", "B000000002", "4873117526", "B000000003"},
+	}).DoPartial()
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{2, len(res.Items())}.Compare(t)
+	Test{5, len(res.Results)}.Compare(t)
+	for _, test := range []Test{
+		{ItemLookupStatusFound, res.Results[0].Status},
+		{"プログラミング言語Go", res.Results[0].Item.ItemAttributes.Title},
+		{ItemLookupStatusInvalid, res.Results[1].Status},
+		{InvalidParameterValue, res.Results[1].Error.Code},
+		{ItemLookupStatusNotAccessible, res.Results[2].Status},
+		{ItemNotAccessible, res.Results[2].Error.Code},
+		{ItemLookupStatusFound, res.Results[3].Status},
+		{ItemLookupStatusNotFound, res.Results[4].Status},
+	} {
+		test.Compare(t)
+	}
+	Test{[]string{"4621300253", "4873117526"}, res.ItemIDs(ItemLookupStatusFound)}.DeepEqual(t)
+	r, ok := res.Result("B000000002")
+	Test{true, ok}.Compare(t)
+	Test{ItemLookupStatusNotAccessible, r.Status}.Compare(t)
+	_, ok = res.Result("foo")
+	Test{false, ok}.Compare(t)
+}
+
+func TestItemLookupDoPartialISBN(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{{200, partialItemLookupResponse}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	res, err := client.ItemLookup(ItemLookupParameters{
+		IDType:      IDTypeISBN,
+		SearchIndex: SearchIndexBooks,
+		ItemIDs:     []string{"9784621300251", "B000000001", "B000000002"},
+	}).DoPartial()
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{ItemLookupStatusFound, res.Results[0].Status}.Compare(t)
+	Test{"4621300253", res.Results[0].Item.ASIN}.Compare(t)
+	Test{ItemLookupStatusInvalid, res.Results[1].Status}.Compare(t)
+	Test{ItemLookupStatusNotAccessible, res.Results[2].Status}.Compare(t)
+}
+
+func TestItemLookupDoPartialRequestError(t *testing.T) {
+	data, _ := ioutil.ReadFile("_fixtures/ItemLookupResponseErrorItem.xml")
+	doer := &sequenceDoer{replies: []mockReply{{200, string(data)}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	res, err := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"4621300253"}}).DoPartial()
+	Test{"Error AWS.MissingParameters: リクエストには、必要なパラメータが含まれていません。必要なパラメータには、AssociateTagなどがあります。", err.Error()}.Compare(t)
+	Test{ItemLookupStatusNotFound, res.Results[0].Status}.Compare(t)
+}
+
+func TestItemLookupDoPartialUnresolvedError(t *testing.T) {
+	body := strings.Replace(partialItemLookupResponse, "</Errors>",
+		"<Error><Code>AWS.RestrictedParameterValueCombination</Code><Message>Your request contained a restricted parameter combination.</Message></Error></Errors>", 1)
+	doer := &sequenceDoer{replies: []mockReply{{200, body}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	res, err := client.ItemLookup(ItemLookupParameters{
+		ItemIDs: []string{"4621300253", "B000000001", "B000000002"},
+	}).DoPartial()
+	errs, ok := err.(*Errors)
+	if !ok {
+		t.Fatalf("Expected *Errors but got %v", err)
+	}
+	Test{[]ErrorCode{"AWS.RestrictedParameterValueCombination"}, errs.Codes()}.DeepEqual(t)
+	Test{ItemLookupStatusFound, res.Results[0].Status}.Compare(t)
+	Test{ItemLookupStatusInvalid, res.Results[1].Status}.Compare(t)
+	Test{ItemLookupStatusNotAccessible, res.Results[2].Status}.Compare(t)
+}
+
+func TestItemLookupDoPartialSKU(t *testing.T) {
+	body := `<ItemLookupResponse><Items><Request><IsValid>True</IsValid></Request>` +
+		`<Item><ASIN>B00ZV9RDKK</ASIN><ItemAttributes><SKU>B00ZV9RDKK-FTV</SKU></ItemAttributes></Item>` +
+		`</Items></ItemLookupResponse>`
+	doer := &sequenceDoer{replies: []mockReply{{200, body}}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	res, err := client.ItemLookup(ItemLookupParameters{
+		IDType:      IDTypeSKU,
+		SearchIndex: SearchIndexElectronics,
+		ItemIDs:     []string{"B00ZV9RDKK-FTV", "B00ZV9RDKK"},
+	}).DoPartial()
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{ItemLookupStatusFound, res.Results[0].Status}.Compare(t)
+	Test{"B00ZV9RDKK", res.Results[0].Item.ASIN}.Compare(t)
+	Test{ItemLookupStatusNotFound, res.Results[1].Status}.Compare(t)
+}
@@ -83,7 +83,7 @@ func TestItemLookupSignedURL(t *testing.T) {
 
 func TestItemLookupDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestItemLookupRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/ItemLookupResponseErrorItem.xml")
 	gock.New(strings.Replace(expectedItemLookupSignedURL, "%2B", "%5C%2B", -1)).
@@ -99,7 +99,7 @@ func TestItemLookupDoErrorResponse(t *testing.T) {
 
 func TestItemLookupDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestItemLookupRequest(client)
 	gock.New(strings.Replace(expectedItemLookupSignedURL, "%2B", "%5C%2B", -1)).
 		ReplyError(errors.New("omg"))
@@ -107,13 +107,13 @@ func TestItemLookupDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedItemLookupSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedItemLookupSignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestItemLookupDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestItemLookupRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/ItemLookup.xml")
 	gock.New(expectedItemLookupSignedURL).
@@ -0,0 +1,140 @@
+package amazon
+
+import (
+	"context"
+	"errors"
+)
+
+// variationsResponseGroups are response groups those return Variations of the parent item
+var variationsResponseGroups = []ItemLookupResponseGroup{
+	ItemLookupResponseGroupVariations,
+	ItemLookupResponseGroupVariationMatrix,
+	ItemLookupResponseGroupVariationMinimum,
+	ItemLookupResponseGroupVariationOffers,
+}
+
+// VariationIterator walks child items of a parent item of ItemLookup variation page by variation page.
+// Pages are requested lazily, up to TotalVariationPages
+//
+//	it := client.ItemLookup(amazon.ItemLookupParameters{ItemIDs: []string{parentASIN}}).VariationIterator(ctx)
+//	for it.Next() {
+//		child := it.Item()
+//		color := child.VariationAttributes.Value("Color")
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type VariationIterator struct {
+	walker   pageWalker
+	req      ItemLookupRequest
+	response *ItemLookupResponse
+}
+
+// VariationIterator returns new VariationIterator of the parent item in ItemIDs starting from VariationPage in parameters.
+// Variations response group is added unless any response group returning Variations is specified
+func (req *ItemLookupRequest) VariationIterator(ctx context.Context) *VariationIterator {
+	it := &VariationIterator{req: *req}
+	it.walker = pageWalker{
+		ctx:   ctx,
+		start: req.Parameters.VariationPage,
+		fetch: it.fetch,
+	}
+	if len(req.Parameters.ItemIDs) != 1 {
+		it.walker.err = errors.New("VariationIterator requires exactly one ItemID")
+	}
+	groups := append([]ItemLookupResponseGroup{}, req.Parameters.ResponseGroups...)
+	if !hasVariationsResponseGroup(groups) {
+		groups = append(groups, ItemLookupResponseGroupVariations)
+	}
+	it.req.Parameters.ResponseGroups = groups
+	return it
+}
+
+func hasVariationsResponseGroup(groups []ItemLookupResponseGroup) bool {
+	for _, group := range groups {
+		for _, g := range variationsResponseGroups {
+			if group == g {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Next advances to the next child item, requesting the next variation page if needed.
+// It returns false when there are no more items or an error occurred
+func (it *VariationIterator) Next() bool {
+	return it.walker.next()
+}
+
+func (it *VariationIterator) fetch(ctx context.Context, page int) (int, int, error) {
+	req := it.req
+	req.Parameters.VariationPage = page
+	res, err := req.DoContext(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	it.response = res
+	if variations := it.variations(); variations != nil {
+		return len(variations.Item), variations.TotalVariationPages, nil
+	}
+	return 0, 0, nil
+}
+
+func (it *VariationIterator) variations() *Variations {
+	if parent := it.Parent(); parent != nil {
+		return &parent.Variations
+	}
+	return nil
+}
+
+// Item returns the current child item
+func (it *VariationIterator) Item() Item {
+	return it.variations().Item[it.walker.index]
+}
+
+// Parent returns the parent item in the current page, or nil if not found
+func (it *VariationIterator) Parent() *Item {
+	if it.response == nil || len(it.response.Items.Item) == 0 {
+		return nil
+	}
+	return &it.response.Items.Item[0]
+}
+
+// Err returns the error occurred while iterating
+func (it *VariationIterator) Err() error {
+	return it.walker.err
+}
+
+// Page returns the current VariationPage
+func (it *VariationIterator) Page() int {
+	return it.walker.page
+}
+
+// Response returns response of the current page
+func (it *VariationIterator) Response() *ItemLookupResponse {
+	return it.response
+}
+
+// TotalVariations returns total number of child items, available after the first call of Next
+func (it *VariationIterator) TotalVariations() int {
+	if variations := it.variations(); variations != nil {
+		return variations.TotalVariations
+	}
+	return 0
+}
+
+// TotalVariationPages returns total number of variation pages, available after the first call of Next
+func (it *VariationIterator) TotalVariationPages() int {
+	if variations := it.variations(); variations != nil {
+		return variations.TotalVariationPages
+	}
+	return 0
+}
+
+// VariationDimensions returns names of dimensions such as Color and Size, available after the first call of Next
+func (it *VariationIterator) VariationDimensions() []string {
+	if variations := it.variations(); variations != nil {
+		return variations.VariationDimensions.VariationDimension
+	}
+	return nil
+}
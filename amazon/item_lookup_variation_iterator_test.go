@@ -0,0 +1,114 @@
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func mockVariationPage(page int, responseGroup, fixture string) {
+	gock.New("https://webservices.amazon.com/onca/xml").
+		MatchParams(map[string]string{
+			"Operation":     "^ItemLookup$",
+			"ItemId":        "^B01M0EKQR2$",
+			"ResponseGroup": "^" + responseGroup + "$",
+			"VariationPage": fmt.Sprintf("^%d$", page),
+		}).
+		Reply(200).
+		File("_fixtures/" + fixture)
+}
+
+func TestVariationIterator(t *testing.T) {
+	for _, test := range []struct {
+		variationPage int
+		pages         map[int]string
+		asins         []string
+		colors        []string
+		page          int
+	}{
+		{0, map[int]string{1: "ItemLookupVariations.xml", 2: "ItemLookupVariations2.xml"},
+			[]string{"B01M0EKR1A", "B01M0EKR2B", "B01M0EKR3C"}, []string{"Dark Stonewash", "Black", "Rinse"}, 2},
+		{2, map[int]string{2: "ItemLookupVariations2.xml"},
+			[]string{"B01M0EKR3C"}, []string{"Rinse"}, 2},
+	} {
+		gock.DisableNetworking()
+		for page, fixture := range test.pages {
+			mockVariationPage(page, "Variations", fixture)
+		}
+		client, _ := New("AK", "SK", "ngsio-20", RegionUS, WithRateLimiter(nil))
+		it := client.ItemLookup(ItemLookupParameters{
+			ItemIDs:       []string{"B01M0EKQR2"},
+			VariationPage: test.variationPage,
+		}).VariationIterator(context.Background())
+		Test{0, it.TotalVariations()}.Compare(t)
+		var asins, colors []string
+		for it.Next() {
+			Test{"B01M0EKQR2", it.Item().ParentASIN}.Compare(t)
+			asins = append(asins, it.Item().ASIN)
+			colors = append(colors, it.Item().VariationAttributes.Value("Color"))
+		}
+		Test{nil, it.Err()}.Compare(t)
+		Test{true, gock.IsDone()}.Compare(t)
+		Test{test.asins, asins}.DeepEqual(t)
+		Test{test.colors, colors}.DeepEqual(t)
+		Test{12, it.TotalVariations()}.Compare(t)
+		Test{2, it.TotalVariationPages()}.Compare(t)
+		Test{[]string{"Size", "Color"}, it.VariationDimensions()}.DeepEqual(t)
+		Test{"B01M0EKQR2", it.Parent().ASIN}.Compare(t)
+		Test{test.page, it.Page()}.Compare(t)
+		gock.Off()
+	}
+}
+
+func TestVariationIteratorNoVariations(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	gock.New("https://webservices.amazon.co.jp/onca/xml").
+		MatchParams(map[string]string{
+			"ItemId":        "^4621300253$",
+			"VariationPage": "^1$",
+		}).
+		Reply(200).
+		File("_fixtures/ItemLookupEditorialReview.xml")
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	it := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"4621300253"}}).VariationIterator(context.Background())
+	Test{false, it.Next()}.Compare(t)
+	Test{nil, it.Err()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+	Test{0, it.TotalVariations()}.Compare(t)
+	Test{1, it.Page()}.Compare(t)
+}
+
+func TestVariationIteratorResponseGroups(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	mockVariationPage(1, "VariationSummary,Variations", "ItemLookupVariations.xml")
+	client, _ := New("AK", "SK", "ngsio-20", RegionUS, WithRateLimiter(nil))
+	params := ItemLookupParameters{
+		ItemIDs:        []string{"B01M0EKQR2"},
+		ResponseGroups: []ItemLookupResponseGroup{ItemLookupResponseGroupVariationSummary},
+	}
+	it := client.ItemLookup(params).VariationIterator(context.Background())
+	Test{true, it.Next()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+	Test{[]ItemLookupResponseGroup{ItemLookupResponseGroupVariationSummary}, params.ResponseGroups}.DeepEqual(t)
+
+	mockVariationPage(1, "VariationOffers", "ItemLookupVariations.xml")
+	params.ResponseGroups = []ItemLookupResponseGroup{ItemLookupResponseGroupVariationOffers}
+	it = client.ItemLookup(params).VariationIterator(context.Background())
+	Test{true, it.Next()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+}
+
+func TestVariationIteratorItemIDs(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	gock.CleanUnmatchedRequest()
+	client, _ := New("AK", "SK", "ngsio-20", RegionUS, WithRateLimiter(nil))
+	it := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"A", "B"}}).VariationIterator(context.Background())
+	Test{false, it.Next()}.Compare(t)
+	Test{"VariationIterator requires exactly one ItemID", it.Err().Error()}.Compare(t)
+	Test{false, gock.HasUnmatchedRequest()}.Compare(t)
+}
@@ -1,6 +1,10 @@
 package amazon
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
 
 // ItemSearchResponseGroup represents constants those are capable ResponseGroups parameter
 type ItemSearchResponseGroup string
@@ -197,10 +201,16 @@ type ItemSearchParameters struct {
 	Manufacturer string
 	// MaxPrice Specifies the maximum item price in the response. Prices appear in the lowest currency denomination. For example, 3241 is $32.41. MaximumPrice can be used with every index, except All and Blended.
 	MaximumPrice int
+	// MaximumPriceMoney Specifies the maximum item price in the currency of the region of Client, instead of MaximumPrice.
+	// The request fails before sending if the currency does not match, such as USD for RegionJapan
+	MaximumPriceMoney *Money
 	// MerchantId Filters search results and offer listings to items sold by Amazon. By default, the Product Advertising API returns items sold by merchants and Amazon.
 	MerchantID string
 	// MinimumPrice Specifies the minimum item price in the response. Prices appear in the lowest currency denomination. For example, 3241 is $32.41. MinimumPrice can be used with every index, except All and Blended.
 	MinimumPrice int
+	// MinimumPriceMoney Specifies the minimum item price in the currency of the region of Client, instead of MinimumPrice.
+	// The request fails before sending if the currency does not match, such as USD for RegionJapan
+	MinimumPriceMoney *Money
 	// MinPercentageOff Specifies the minimum percentage off the item price.
 	MinPercentageOff int
 	// Orchestra Orchestra name associated with the item. You can enter all or part of the name.
@@ -226,6 +236,8 @@ type ItemSearchParameters struct {
 	VariationPage *int
 	// Specifies the types of values to return. Separate
 	ResponseGroups []ItemSearchResponseGroup
+	// AssociateTag overrides associate tag of Client for the request if not empty
+	AssociateTag string
 }
 
 // ItemSearchRequest represents request for ItemSearch operation
@@ -233,6 +245,8 @@ type ItemSearchParameters struct {
 type ItemSearchRequest struct {
 	Client     *Client
 	Parameters ItemSearchParameters
+	// boundedPrice sends MaximumPrice even if zero, which is omitted otherwise as no upper bound
+	boundedPrice bool
 }
 
 // ItemSearchResponse represents response for ItemSearch operation
@@ -249,10 +263,51 @@ func (res *ItemSearchResponse) Error() error {
 	return nil
 }
 
+// priceAmount returns amount in the lowest currency denomination of the region from money if specified, or amount
+func priceAmount(name string, amount int, money *Money, region Region) (int, error) {
+	if money == nil {
+		return amount, nil
+	}
+	if amount != 0 {
+		return 0, fmt.Errorf("%v and %vMoney are both specified", name, name)
+	}
+	if money.Amount < 0 {
+		return 0, fmt.Errorf("Invalid %vMoney %v", name, money)
+	}
+	if currency := region.Currency(); money.Currency != currency {
+		return 0, fmt.Errorf("Invalid %vMoney %v: currency of Region %v is %v", name, money, region, currency)
+	}
+	return int(money.Amount), nil
+}
+
+// priceRange returns MinimumPrice and MaximumPrice in the lowest currency denomination of the region of Client
+func (req *ItemSearchRequest) priceRange() (int, int, error) {
+	var region Region
+	if req.Client != nil {
+		region = req.Client.Region
+	}
+	p := req.Parameters
+	min, err := priceAmount("MinimumPrice", p.MinimumPrice, p.MinimumPriceMoney, region)
+	if err != nil {
+		return 0, 0, err
+	}
+	max, err := priceAmount("MaximumPrice", p.MaximumPrice, p.MaximumPriceMoney, region)
+	if err != nil {
+		return 0, 0, err
+	}
+	return min, max, nil
+}
+
+func (req *ItemSearchRequest) validate() error {
+	_, _, err := req.priceRange()
+	return err
+}
+
 // Query returns query for sending request
 func (req *ItemSearchRequest) Query() map[string]interface{} {
 	q := map[string]interface{}{}
 	p := req.Parameters
+	minPrice, maxPrice, _ := req.priceRange()
 	for k, strp := range map[string]string{
 		"Actor":            p.Actor,
 		"Artist":           p.Artist,
@@ -281,8 +336,8 @@ func (req *ItemSearchRequest) Query() map[string]interface{} {
 	}
 	for k, intp := range map[string]int{
 		"ItemPage":         p.ItemPage,
-		"MaximumPrice":     p.MaximumPrice,
-		"MinimumPrice":     p.MinimumPrice,
+		"MaximumPrice":     maxPrice,
+		"MinimumPrice":     minPrice,
 		"MinPercentageOff": p.MinPercentageOff,
 		"RelatedItemPage":  p.RelatedItemPage,
 	} {
@@ -290,6 +345,9 @@ func (req *ItemSearchRequest) Query() map[string]interface{} {
 			q[k] = intp
 		}
 	}
+	if req.boundedPrice {
+		q["MaximumPrice"] = maxPrice
+	}
 	if p.OnlyAvailable {
 		q["Availability"] = "Available"
 	}
@@ -303,6 +361,9 @@ func (req *ItemSearchRequest) Query() map[string]interface{} {
 		q["VariationPage"] = *p.VariationPage
 	}
 	q["ResponseGroup"] = p.ResponseGroups
+	if p.AssociateTag != "" {
+		q["AssociateTag"] = p.AssociateTag
+	}
 	return q
 }
 
@@ -316,8 +377,13 @@ func (req *ItemSearchRequest) operation() string {
 
 // Do sends request for the API
 func (req *ItemSearchRequest) Do() (*ItemSearchResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *ItemSearchRequest) DoContext(ctx context.Context) (*ItemSearchResponse, error) {
 	respObj := ItemSearchResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
 	if err := respObj.Error(); err != nil {
@@ -326,6 +392,31 @@ func (req *ItemSearchRequest) Do() (*ItemSearchResponse, error) {
 	return &respObj, nil
 }
 
+// doPageContext sends request for the ItemPage, returning response without items instead of NoExactMatches error
+func (req *ItemSearchRequest) doPageContext(ctx context.Context, page int) (*ItemSearchResponse, error) {
+	r := *req
+	r.Parameters.ItemPage = page
+	res, err := r.DoContext(ctx)
+	if isNoExactMatches(err) {
+		return &ItemSearchResponse{}, nil
+	}
+	return res, err
+}
+
+// isNoExactMatches returns whether err consists only of NoExactMatches, which ItemSearch returns if nothing is found
+func isNoExactMatches(err error) bool {
+	errs, ok := err.(*Errors)
+	if !ok || len(errs.ErrorNode) == 0 {
+		return false
+	}
+	for _, node := range errs.ErrorNode {
+		if node.Code != NoExactMatches {
+			return false
+		}
+	}
+	return true
+}
+
 // ItemSearch returns new request for ItemSearch
 func (client *Client) ItemSearch(parameters ItemSearchParameters) *ItemSearchRequest {
 	return &ItemSearchRequest{
@@ -0,0 +1,227 @@
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
+
+const (
+	// initialCrawlerMaximumPrice is the first upper bound tried when MaximumPrice is not specified
+	initialCrawlerMaximumPrice = 1000
+	// maxCrawlerMaximumPrice is the largest upper bound tried when MaximumPrice is not specified
+	maxCrawlerMaximumPrice = math.MaxInt32
+)
+
+// ItemSearchTruncatedError represents results of ItemSearch truncated at the page limit,
+// because neither the price range nor the BrowseNode can be split any more
+type ItemSearchTruncatedError struct {
+	MinimumPrice int
+	MaximumPrice int
+	BrowseNode   string
+	TotalResults int
+}
+
+func (e *ItemSearchTruncatedError) Error() string {
+	if e.BrowseNode != "" {
+		return fmt.Sprintf("Results of price range %d-%d in BrowseNode %v are truncated: %d results", e.MinimumPrice, e.MaximumPrice, e.BrowseNode, e.TotalResults)
+	}
+	return fmt.Sprintf("Results of price range %d-%d are truncated: %d results", e.MinimumPrice, e.MaximumPrice, e.TotalResults)
+}
+
+// ItemSearchCrawler enumerates items beyond the page limit of ItemSearch.
+// Whenever TotalPages exceeds the limit, it bisects the price range with MinimumPrice and MaximumPrice,
+// and optionally splits the BrowseNode into its children, then searches each of them
+type ItemSearchCrawler struct {
+	req ItemSearchRequest
+	// MinimumPrice is the lower bound of the price range in the lowest currency denomination
+	MinimumPrice int
+	// MaximumPrice is the upper bound of the price range in the lowest currency denomination.
+	// If zero, the upper bound is derived by doubling it until the range covers all results
+	MaximumPrice int
+	// FollowBrowseNodes enables splitting BrowseNode into its children with BrowseNodeLookup
+	// when the price range cannot be bisected any more
+	FollowBrowseNodes bool
+
+	mu        sync.Mutex
+	err       error
+	truncated []*ItemSearchTruncatedError
+}
+
+// Crawler returns new ItemSearchCrawler with the price range in parameters.
+// MinimumPriceMoney and MaximumPriceMoney are converted into the lowest currency denomination of the region
+func (req *ItemSearchRequest) Crawler() *ItemSearchCrawler {
+	min, max, err := req.priceRange()
+	crawler := &ItemSearchCrawler{req: *req, MinimumPrice: min, MaximumPrice: max, err: err}
+	crawler.req.Parameters.MinimumPriceMoney = nil
+	crawler.req.Parameters.MaximumPriceMoney = nil
+	return crawler
+}
+
+// Crawl starts crawling and returns channel streaming unique items.
+// The channel is closed when crawling finishes, an error occurs or the context is done
+func (crawler *ItemSearchCrawler) Crawl(ctx context.Context) <-chan Item {
+	ch := make(chan Item)
+	go func() {
+		defer close(ch)
+		if crawler.Err() != nil {
+			return
+		}
+		seen := map[string]bool{}
+		p := crawler.req.Parameters
+		if err := crawler.crawl(ctx, p, crawler.MinimumPrice, crawler.MaximumPrice, crawler.MaximumPrice > 0, seen, ch); err != nil {
+			crawler.setErr(err)
+		}
+	}()
+	return ch
+}
+
+// Err returns the error stopped crawling, available after the channel is closed.
+// If crawling finished but some results are truncated, the first *ItemSearchTruncatedError is returned
+func (crawler *ItemSearchCrawler) Err() error {
+	crawler.mu.Lock()
+	defer crawler.mu.Unlock()
+	if crawler.err == nil && len(crawler.truncated) > 0 {
+		return crawler.truncated[0]
+	}
+	return crawler.err
+}
+
+// Truncated returns all price ranges whose results are truncated at the page limit
+func (crawler *ItemSearchCrawler) Truncated() []*ItemSearchTruncatedError {
+	crawler.mu.Lock()
+	defer crawler.mu.Unlock()
+	return crawler.truncated
+}
+
+func (crawler *ItemSearchCrawler) setErr(err error) {
+	crawler.mu.Lock()
+	defer crawler.mu.Unlock()
+	crawler.err = err
+}
+
+func (crawler *ItemSearchCrawler) addTruncated(err *ItemSearchTruncatedError) {
+	crawler.mu.Lock()
+	defer crawler.mu.Unlock()
+	crawler.truncated = append(crawler.truncated, err)
+}
+
+// search requests the page of the price range, sending MaximumPrice if bounded even if it is zero.
+// NoExactMatches is returned as response without items, as bisected price ranges may be empty
+func (crawler *ItemSearchCrawler) search(ctx context.Context, p ItemSearchParameters, bounded bool, page int) (*ItemSearchResponse, error) {
+	req := crawler.req
+	req.Parameters = p
+	req.boundedPrice = bounded
+	return req.doPageContext(ctx, page)
+}
+
+// crawl emits items in the price range from min to max, or from min without upper bound unless bounded
+func (crawler *ItemSearchCrawler) crawl(ctx context.Context, p ItemSearchParameters, min, max int, bounded bool, seen map[string]bool, ch chan<- Item) error {
+	p.MinimumPrice = min
+	p.MaximumPrice = max
+	res, err := crawler.search(ctx, p, bounded, 1)
+	if err != nil {
+		return err
+	}
+	maxPage := p.SearchIndex.MaxItemPage()
+	if res.Items.TotalPages > maxPage {
+		if !bounded {
+			if max, err = crawler.upperBound(ctx, p, min, res.Items.TotalResults); err != nil {
+				return err
+			}
+			bounded = true
+		}
+		if min < max {
+			mid := min + (max-min)/2
+			if err := crawler.crawl(ctx, p, min, mid, true, seen, ch); err != nil {
+				return err
+			}
+			return crawler.crawl(ctx, p, mid+1, max, true, seen, ch)
+		}
+		if crawler.FollowBrowseNodes && p.BrowseNode != "" {
+			children, err := crawler.childBrowseNodes(ctx, p.BrowseNode)
+			if err != nil {
+				return err
+			}
+			if len(children) > 0 {
+				for _, child := range children {
+					cp := p
+					cp.BrowseNode = child.ID
+					if err := crawler.crawl(ctx, cp, min, max, true, seen, ch); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		}
+		crawler.addTruncated(&ItemSearchTruncatedError{
+			MinimumPrice: min,
+			MaximumPrice: max,
+			BrowseNode:   p.BrowseNode,
+			TotalResults: res.Items.TotalResults,
+		})
+	}
+	lastPage := res.Items.TotalPages
+	if lastPage > maxPage {
+		lastPage = maxPage
+	}
+	for page := 1; ; page++ {
+		if page > 1 {
+			if res, err = crawler.search(ctx, p, bounded, page); err != nil {
+				return err
+			}
+		}
+		for _, item := range res.Items.Item {
+			if seen[item.ASIN] {
+				continue
+			}
+			seen[item.ASIN] = true
+			select {
+			case ch <- item:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if page >= lastPage {
+			return nil
+		}
+	}
+}
+
+// upperBound doubles the upper bound of the price range from min until it covers totalResults
+func (crawler *ItemSearchCrawler) upperBound(ctx context.Context, p ItemSearchParameters, min, totalResults int) (int, error) {
+	max := min * 2
+	if max < initialCrawlerMaximumPrice {
+		max = initialCrawlerMaximumPrice
+	}
+	for {
+		if max > maxCrawlerMaximumPrice/2 {
+			return maxCrawlerMaximumPrice, nil
+		}
+		p.MinimumPrice = min
+		p.MaximumPrice = max
+		res, err := crawler.search(ctx, p, true, 1)
+		if err != nil {
+			return 0, err
+		}
+		if res.Items.TotalResults >= totalResults {
+			return max, nil
+		}
+		max *= 2
+	}
+}
+
+func (crawler *ItemSearchCrawler) childBrowseNodes(ctx context.Context, browseNodeID string) ([]BrowseNode, error) {
+	res, err := crawler.req.Client.BrowseNodeLookup(BrowseNodeLookupParameters{
+		BrowseNodeID: browseNodeID,
+	}).DoContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var children []BrowseNode
+	for _, node := range res.BrowseNodes() {
+		children = append(children, node.Children.BrowseNode...)
+	}
+	return children, nil
+}
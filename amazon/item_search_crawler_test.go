@@ -0,0 +1,207 @@
+package amazon_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/amazontest"
+)
+
+type Test struct {
+	expected interface{}
+	actual   interface{}
+}
+
+func (test Test) Compare(t *testing.T) {
+	if test.expected != test.actual {
+		_, file, line, _ := runtime.Caller(1)
+		t.Errorf("%v:%v Expected %v but got %v", file, line, test.expected, test.actual)
+	}
+}
+
+func (test Test) DeepEqual(t *testing.T) {
+	if !reflect.DeepEqual(test.expected, test.actual) {
+		_, file, line, _ := runtime.Caller(1)
+		t.Errorf("%v:%v Expected %v but got %v", file, line, test.expected, test.actual)
+	}
+}
+
+func newCrawlerItem(i, price int, browseNode string) amazon.Item {
+	item := amazon.Item{ASIN: fmt.Sprintf("ASIN%03d", i)}
+	item.ItemAttributes.Title = fmt.Sprintf("Go Book %d", i)
+	item.ItemAttributes.ListPrice = amazon.Price{
+		Amount:         fmt.Sprint(price),
+		CurrencyCode:   "JPY",
+		FormattedPrice: fmt.Sprintf("￥ %d", price),
+	}
+	if browseNode != "" {
+		item.BrowseNodes.BrowseNode = []amazon.BrowseNode{{ID: browseNode, Ancestors: amazon.BrowseNodes{BrowseNode: []amazon.BrowseNode{{ID: "1"}}}}}
+	}
+	return item
+}
+
+func newCrawlerServer(prices ...int) *amazontest.Server {
+	server := amazontest.NewServer("AK", "SK")
+	for i, price := range prices {
+		server.Catalog.AddItem(newCrawlerItem(i, price, ""))
+	}
+	return server
+}
+
+func crawl(t *testing.T, crawler *amazon.ItemSearchCrawler) map[string]bool {
+	seen := map[string]bool{}
+	for item := range crawler.Crawl(context.Background()) {
+		if seen[item.ASIN] {
+			t.Errorf("Duplicated item %v", item.ASIN)
+		}
+		seen[item.ASIN] = true
+	}
+	return seen
+}
+
+func TestItemSearchCrawlerPriceRange(t *testing.T) {
+	var prices []int
+	for i := 0; i < 350; i++ {
+		prices = append(prices, i*3)
+	}
+	server := newCrawlerServer(prices...)
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	crawler := client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex:  amazon.SearchIndexBooks,
+		Keywords:     "Go",
+		MaximumPrice: 2000,
+	}).Crawler()
+	seen := crawl(t, crawler)
+	Test{nil, crawler.Err()}.Compare(t)
+	Test{350, len(seen)}.Compare(t)
+}
+
+func TestItemSearchCrawlerFreeItems(t *testing.T) {
+	var prices []int
+	for i := 0; i < 120; i++ {
+		prices = append(prices, i%2)
+	}
+	for i := 0; i < 50; i++ {
+		prices = append(prices, 1000+i)
+	}
+	server := newCrawlerServer(prices...)
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	crawler := client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex:  amazon.SearchIndexBooks,
+		MaximumPrice: 2000,
+	}).Crawler()
+	seen := crawl(t, crawler)
+	Test{nil, crawler.Err()}.Compare(t)
+	Test{170, len(seen)}.Compare(t)
+	if server.Requests() > 200 {
+		t.Errorf("Expected price ranges to be bisected finitely but got %d requests", server.Requests())
+	}
+}
+
+func TestItemSearchCrawlerEmptyRanges(t *testing.T) {
+	for _, test := range []struct {
+		minPrice     int
+		maxPrice     int
+		maximumPrice int
+	}{
+		{3000, 3119, 4000},
+		{100, 219, 0},
+	} {
+		var prices []int
+		for price := test.minPrice; price <= test.maxPrice; price++ {
+			prices = append(prices, price)
+		}
+		server := newCrawlerServer(prices...)
+		client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+		crawler := client.ItemSearch(amazon.ItemSearchParameters{
+			SearchIndex:  amazon.SearchIndexBooks,
+			MaximumPrice: test.maximumPrice,
+		}).Crawler()
+		seen := crawl(t, crawler)
+		Test{nil, crawler.Err()}.Compare(t)
+		Test{len(prices), len(seen)}.Compare(t)
+		server.Close()
+	}
+}
+
+func TestItemSearchCrawlerBrowseNodes(t *testing.T) {
+	server := amazontest.NewServer("AK", "SK")
+	defer server.Close()
+	for i := 0; i < 240; i++ {
+		server.Catalog.AddItem(newCrawlerItem(i, 1000, fmt.Sprintf("1%d", i%3+1)))
+	}
+	server.Catalog.AddBrowseNode(amazon.BrowseNode{
+		ID:       "1",
+		Children: amazon.BrowseNodes{BrowseNode: []amazon.BrowseNode{{ID: "11"}, {ID: "12"}, {ID: "13"}}},
+	})
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	crawler := client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex: amazon.SearchIndexBooks,
+		BrowseNode:  "1",
+	}).Crawler()
+	crawler.FollowBrowseNodes = true
+	seen := crawl(t, crawler)
+	Test{nil, crawler.Err()}.Compare(t)
+	Test{240, len(seen)}.Compare(t)
+}
+
+func TestItemSearchCrawlerCapped(t *testing.T) {
+	var prices []int
+	for i := 0; i < 150; i++ {
+		prices = append(prices, 1000)
+	}
+	server := newCrawlerServer(prices...)
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	crawler := client.ItemSearch(amazon.ItemSearchParameters{SearchIndex: amazon.SearchIndexBooks}).Crawler()
+	seen := crawl(t, crawler)
+	Test{100, len(seen)}.Compare(t)
+	err, ok := crawler.Err().(*amazon.ItemSearchTruncatedError)
+	if !ok {
+		t.Fatalf("Expected *ItemSearchTruncatedError but got %v", crawler.Err())
+	}
+	Test{"Results of price range 1000-1000 are truncated: 150 results", err.Error()}.Compare(t)
+	Test{[]*amazon.ItemSearchTruncatedError{err}, crawler.Truncated()}.DeepEqual(t)
+}
+
+func TestItemSearchCrawlerUpperBound(t *testing.T) {
+	var prices []int
+	for i := 0; i < 350; i++ {
+		prices = append(prices, i*10)
+	}
+	server := newCrawlerServer(prices...)
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	crawler := client.ItemSearch(amazon.ItemSearchParameters{
+		SearchIndex:  amazon.SearchIndexBooks,
+		MinimumPrice: 100,
+	}).Crawler()
+	seen := crawl(t, crawler)
+	Test{nil, crawler.Err()}.Compare(t)
+	Test{340, len(seen)}.Compare(t)
+	Test{0, len(crawler.Truncated())}.Compare(t)
+}
+
+func TestItemSearchCrawlerCanceled(t *testing.T) {
+	var prices []int
+	for i := 0; i < 50; i++ {
+		prices = append(prices, 1000)
+	}
+	server := newCrawlerServer(prices...)
+	defer server.Close()
+	client, _ := server.NewClient("ngsio-22", amazon.RegionJapan)
+	crawler := client.ItemSearch(amazon.ItemSearchParameters{SearchIndex: amazon.SearchIndexBooks}).Crawler()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := crawler.Crawl(ctx)
+	<-ch
+	cancel()
+	for range ch {
+	}
+	Test{context.Canceled, crawler.Err()}.Compare(t)
+}
@@ -0,0 +1,97 @@
+package amazon
+
+import "context"
+
+const (
+	// MaxItemSearchPage is maximum ItemPage for ItemSearch
+	MaxItemSearchPage = 10
+	// MaxItemSearchPageAll is maximum ItemPage for ItemSearch when SearchIndex is All
+	MaxItemSearchPageAll = 5
+)
+
+// MaxItemPage returns maximum ItemPage for ItemSearch with the search index
+func (index SearchIndex) MaxItemPage() int {
+	if index == SearchIndexAll {
+		return MaxItemSearchPageAll
+	}
+	return MaxItemSearchPage
+}
+
+// ItemSearchIterator walks items of ItemSearch page by page.
+// Pages are requested lazily, up to TotalPages or the page limit of the search index.
+// Search without results ends with no items and no error instead of NoExactMatches
+//
+//	it := client.ItemSearch(params).Iterator(ctx)
+//	for it.Next() {
+//		item := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type ItemSearchIterator struct {
+	walker   pageWalker
+	req      ItemSearchRequest
+	response *ItemSearchResponse
+}
+
+// Iterator returns new ItemSearchIterator starting from ItemPage in parameters
+func (req *ItemSearchRequest) Iterator(ctx context.Context) *ItemSearchIterator {
+	it := &ItemSearchIterator{req: *req}
+	it.walker = pageWalker{
+		ctx:     ctx,
+		start:   req.Parameters.ItemPage,
+		maxPage: req.Parameters.SearchIndex.MaxItemPage(),
+		fetch:   it.fetch,
+	}
+	return it
+}
+
+// Next advances to the next item, requesting the next page if needed.
+// It returns false when there are no more items or an error occurred
+func (it *ItemSearchIterator) Next() bool {
+	return it.walker.next()
+}
+
+func (it *ItemSearchIterator) fetch(ctx context.Context, page int) (int, int, error) {
+	res, err := it.req.doPageContext(ctx, page)
+	if err != nil {
+		return 0, 0, err
+	}
+	it.response = res
+	return len(res.Items.Item), res.Items.TotalPages, nil
+}
+
+// Item returns the current item
+func (it *ItemSearchIterator) Item() Item {
+	return it.response.Items.Item[it.walker.index]
+}
+
+// Err returns the error occurred while iterating
+func (it *ItemSearchIterator) Err() error {
+	return it.walker.err
+}
+
+// Page returns the current ItemPage
+func (it *ItemSearchIterator) Page() int {
+	return it.walker.page
+}
+
+// Response returns response of the current page
+func (it *ItemSearchIterator) Response() *ItemSearchResponse {
+	return it.response
+}
+
+// TotalResults returns total number of results found, available after the first call of Next
+func (it *ItemSearchIterator) TotalResults() int {
+	if it.response == nil {
+		return 0
+	}
+	return it.response.Items.TotalResults
+}
+
+// TotalPages returns total number of pages found, available after the first call of Next
+func (it *ItemSearchIterator) TotalPages() int {
+	if it.response == nil {
+		return 0
+	}
+	return it.response.Items.TotalPages
+}
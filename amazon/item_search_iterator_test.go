@@ -0,0 +1,132 @@
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func mockItemSearchPage(page int, fixture string) {
+	gock.New("https://webservices.amazon.co.jp/onca/xml").
+		MatchParam("Operation", "^ItemSearch$").
+		MatchParam("ItemPage", fmt.Sprintf("^%d$", page)).
+		Reply(200).
+		File("_fixtures/" + fixture)
+}
+
+func TestItemSearchIterator(t *testing.T) {
+	for _, test := range []struct {
+		searchIndex  SearchIndex
+		itemPage     int
+		lastPage     int
+		totalResults int
+		totalPages   int
+		items        int
+	}{
+		{SearchIndexBooks, 0, 3, 25, 3, 25},
+		{SearchIndexBooks, 2, 3, 25, 3, 15},
+		{SearchIndexBooks, 0, 0, 190, 19, 100},
+		{SearchIndexAll, 0, 0, 190, 19, 50},
+	} {
+		gock.DisableNetworking()
+		firstPage := test.itemPage
+		if firstPage == 0 {
+			firstPage = 1
+		}
+		maxPage := test.searchIndex.MaxItemPage()
+		for page := firstPage; page <= maxPage; page++ {
+			if page == test.lastPage {
+				mockItemSearchPage(page, "ItemSearchLastPage.xml")
+				break
+			}
+			mockItemSearchPage(page, "ItemSearch.xml")
+		}
+		client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+		it := client.ItemSearch(ItemSearchParameters{
+			SearchIndex: test.searchIndex,
+			Keywords:    "Go",
+			ItemPage:    test.itemPage,
+		}).Iterator(context.Background())
+		Test{0, it.TotalResults()}.Compare(t)
+		var asins []string
+		for it.Next() {
+			asins = append(asins, it.Item().ASIN)
+		}
+		if err := it.Err(); err != nil {
+			t.Errorf("Expected nil but got %v", err)
+		}
+		Test{true, gock.IsDone()}.Compare(t)
+		Test{test.items, len(asins)}.Compare(t)
+		Test{test.totalResults, it.TotalResults()}.Compare(t)
+		Test{test.totalPages, it.TotalPages()}.Compare(t)
+		if test.lastPage > 0 {
+			Test{test.lastPage, it.Page()}.Compare(t)
+		} else {
+			Test{maxPage, it.Page()}.Compare(t)
+		}
+		Test{"4621300253", asins[0]}.Compare(t)
+		gock.Off()
+	}
+}
+
+func TestItemSearchIteratorCanceled(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	mockItemSearchPage(1, "ItemSearch.xml")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	it := client.ItemSearch(ItemSearchParameters{SearchIndex: SearchIndexBooks}).Iterator(ctx)
+	count := 0
+	for it.Next() {
+		count++
+		cancel()
+	}
+	Test{10, count}.Compare(t)
+	Test{context.Canceled, it.Err()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+}
+
+func TestItemSearchIteratorNoExactMatches(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	gock.New("https://webservices.amazon.co.jp/onca/xml").
+		MatchParam("Operation", "^ItemSearch$").
+		MatchParam("ItemPage", "^1$").
+		Reply(200).
+		File("_fixtures/ItemSearchNoExactMatches.xml")
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	it := client.ItemSearch(ItemSearchParameters{SearchIndex: SearchIndexBooks, Keywords: "zzzzzzzzzz"}).Iterator(context.Background())
+	Test{false, it.Next()}.Compare(t)
+	Test{nil, it.Err()}.Compare(t)
+	Test{0, it.TotalResults()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+}
+
+func TestItemSearchIteratorErrorResponse(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	gock.New("https://webservices.amazon.co.jp/onca/xml").
+		MatchParam("Operation", "^ItemSearch$").
+		Reply(200).
+		File("_fixtures/ItemSearchResponseErrorItem.xml")
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	it := client.ItemSearch(ItemSearchParameters{SearchIndex: SearchIndexBooks}).Iterator(context.Background())
+	Test{false, it.Next()}.Compare(t)
+	Test{true, it.Err() != nil}.Compare(t)
+	Test{false, isNoExactMatches(it.Err())}.Compare(t)
+}
+
+func TestIsNoExactMatches(t *testing.T) {
+	for _, test := range []Test{
+		{true, isNoExactMatches(&Errors{ErrorNode: []Error{{Code: NoExactMatches}}})},
+		{false, isNoExactMatches(&Errors{ErrorNode: []Error{{Code: NoExactMatches}, {Code: InvalidParameterValue}}})},
+		{false, isNoExactMatches(&Errors{})},
+		{false, isNoExactMatches(Error{Code: NoExactMatches})},
+		{false, isNoExactMatches(nil)},
+	} {
+		test.Compare(t)
+	}
+}
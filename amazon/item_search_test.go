@@ -1,6 +1,7 @@
 package amazon
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"os"
@@ -129,7 +130,7 @@ func TestItemSearchSignedURL(t *testing.T) {
 
 func TestItemSearchDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestItemSearchRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/ItemSearchResponseErrorItem.xml")
 	gock.New(expectedItemSearchSignedURL).
@@ -145,7 +146,7 @@ func TestItemSearchDoErrorResponse(t *testing.T) {
 
 func TestItemSearchDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestItemSearchRequest(client)
 	gock.New(expectedItemSearchSignedURL).
 		ReplyError(errors.New("omg"))
@@ -153,13 +154,33 @@ func TestItemSearchDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedItemSearchSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedItemSearchSignedURL + "\": omg", err.Error()}.Compare(t)
+	}
+}
+
+func TestItemSearchDoContextDeadlineExceeded(t *testing.T) {
+	defer gock.Off()
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	op := createTestItemSearchRequest(client)
+	fixtureIO, _ := os.Open("_fixtures/ItemSearch.xml")
+	gock.New(expectedItemSearchSignedURL).
+		Reply(200).
+		Body(fixtureIO)
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	res, err := op.DoContext(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected %v but got %v", context.DeadlineExceeded, err)
+	}
+	if res != nil {
+		t.Errorf("Expected nil but got %v", res)
 	}
 }
 
 func TestItemSearchDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestItemSearchRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/ItemSearch.xml")
 	gock.New(expectedItemSearchSignedURL).
@@ -252,8 +273,8 @@ func TestItemSearchDo(t *testing.T) {
 		{"Alan A.A. Donovan", res.Items.Item[0].ItemAttributes.Author[0]},
 		{"Brian W. Kernighan", res.Items.Item[0].ItemAttributes.Author[1]},
 		{"単行本（ソフトカバー）", res.Items.Item[0].ItemAttributes.Binding},
-		{"翻訳", res.Items.Item[0].ItemAttributes.Creator.Role},
-		{"柴田 芳樹", res.Items.Item[0].ItemAttributes.Creator.Name},
+		{"翻訳", res.Items.Item[0].ItemAttributes.Creator[0].Role},
+		{"柴田 芳樹", res.Items.Item[0].ItemAttributes.Creator[0].Name},
 		{"9784621300251", res.Items.Item[0].ItemAttributes.EAN},
 		{1, len(res.Items.Item[0].ItemAttributes.EANList.Element)},
 		{"9784621300251", res.Items.Item[0].ItemAttributes.EANList.Element[0]},
@@ -268,6 +289,7 @@ func TestItemSearchDo(t *testing.T) {
 		{"4104", res.Items.Item[0].ItemAttributes.ListPrice.Amount},
 		{"JPY", res.Items.Item[0].ItemAttributes.ListPrice.CurrencyCode},
 		{"￥ 4,104", res.Items.Item[0].ItemAttributes.ListPrice.FormattedPrice},
+		{NewMoney(4104, CurrencyJPY), res.Items.Item[0].ItemAttributes.ListPrice.Money},
 		{"丸善出版", res.Items.Item[0].ItemAttributes.Manufacturer},
 		{462, res.Items.Item[0].ItemAttributes.NumberOfPages},
 		{"hundredths-inches", res.Items.Item[0].ItemAttributes.PackageDimensions.Width.Units},
@@ -422,3 +444,40 @@ func TestItemSearchDo(t *testing.T) {
 	}
 	// fmt.Printf("res %v\n", res)
 }
+
+func TestItemSearchPriceMoney(t *testing.T) {
+	client, _ := New("AK", "SK", "ngsio-22", RegionUS, WithRateLimiter(nil))
+	min := NewMoney(3241, CurrencyUSD)
+	max, _ := ParseDecimal("100", CurrencyUSD)
+	req := client.ItemSearch(ItemSearchParameters{Keywords: "Go", MinimumPriceMoney: &min, MaximumPriceMoney: &max})
+	Test{3241, req.Query()["MinimumPrice"]}.Compare(t)
+	Test{10000, req.Query()["MaximumPrice"]}.Compare(t)
+	crawler := req.Crawler()
+	Test{3241, crawler.MinimumPrice}.Compare(t)
+	Test{10000, crawler.MaximumPrice}.Compare(t)
+	Test{nil, crawler.Err()}.Compare(t)
+
+	jp, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	req = jp.ItemSearch(ItemSearchParameters{Keywords: "Go", MinimumPriceMoney: &min})
+	_, err := req.Do()
+	Test{"Invalid MinimumPriceMoney 32.41 USD: currency of Region JP is JPY", err.Error()}.Compare(t)
+	Test{nil, req.Query()["MinimumPrice"]}.Compare(t)
+	_, err = jp.SignedURLContext(context.Background(), req)
+	Test{"Invalid MinimumPriceMoney 32.41 USD: currency of Region JP is JPY", err.Error()}.Compare(t)
+	Test{"Invalid MinimumPriceMoney 32.41 USD: currency of Region JP is JPY", req.Crawler().Err().Error()}.Compare(t)
+
+	_, err = client.ItemSearch(ItemSearchParameters{MaximumPrice: 100, MaximumPriceMoney: &max}).Do()
+	Test{"MaximumPrice and MaximumPriceMoney are both specified", err.Error()}.Compare(t)
+	neg := min.Neg()
+	_, err = client.ItemSearch(ItemSearchParameters{MinimumPriceMoney: &neg}).Do()
+	Test{"Invalid MinimumPriceMoney -32.41 USD", err.Error()}.Compare(t)
+}
+
+func TestItemSearchBoundedPrice(t *testing.T) {
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	req := client.ItemSearch(ItemSearchParameters{Keywords: "Go"})
+	Test{nil, req.Query()["MaximumPrice"]}.Compare(t)
+	req.boundedPrice = true
+	Test{0, req.Query()["MaximumPrice"]}.Compare(t)
+	Test{nil, req.Query()["MinimumPrice"]}.Compare(t)
+}
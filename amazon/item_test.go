@@ -2,6 +2,7 @@ package amazon
 
 import (
 	"encoding/xml"
+	"io/ioutil"
 	"testing"
 	"time"
 )
@@ -32,3 +33,227 @@ func TestUnmarshalInvalidDate(t *testing.T) {
 		err.Error(),
 	}.Compare(t)
 }
+
+func TestMarshalDate(t *testing.T) {
+	data, err := xml.Marshal(TestDate{Date: &Date{time.Date(2016, 11, 18, 0, 0, 0, 0, time.UTC)}})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	Test{"<TestDate><Date>2016-11-18</Date></TestDate>", string(data)}.Compare(t)
+}
+
+func TestUnmarshalItemAttributes(t *testing.T) {
+	res := unmarshalItemLookupFixture(t, "ItemLookupItemAttributes.xml")
+	Test{10, len(res.Items.Item)}.Compare(t)
+	book := res.Items.Item[0].ItemAttributes
+	music := res.Items.Item[1].ItemAttributes
+	dvd := res.Items.Item[2].ItemAttributes
+	game := res.Items.Item[3].ItemAttributes
+	apparel := res.Items.Item[4].ItemAttributes
+	shoes := res.Items.Item[5].ItemAttributes
+	electronics := res.Items.Item[6].ItemAttributes
+	toy := res.Items.Item[7].ItemAttributes
+	magazine := res.Items.Item[8].ItemAttributes
+	video := res.Items.Item[9].ItemAttributes
+	for _, test := range []Test{
+		{"Book", book.ProductGroup},
+		{[]string{"Alan A. A. Donovan", "Brian W. Kernighan"}, book.Author},
+		{"1", book.Edition},
+		{true, book.IsEligibleForTradeIn},
+		{NewMoney(1112, CurrencyUSD), book.TradeInValue.Money},
+		{Size{Value: 141, Units: "hundredths-pounds"}, book.ItemDimensions.Weight},
+		{time.Date(2015, 10, 26, 0, 0, 0, 0, time.UTC).UnixNano(), book.ReleaseDate.UnixNano()},
+		{380, book.NumberOfPages},
+		{1, book.NumberOfItems},
+
+		{"Music", music.ProductGroup},
+		{[]string{"Kronos Quartet", "Steve Reich"}, music.Artist},
+		{[]Creator{{Role: "Composer", Name: "Steve Reich"}, {Role: "Performer", Name: "Pat Metheny"}}, music.Creator},
+		{[]string{"Original recording remastered"}, music.AudioFormat},
+		{[]string{"79916"}, music.CatalogNumberList.Element},
+		{"classical-music", music.Genre},
+		{1, music.NumberOfDiscs},
+		{8, music.NumberOfTracks},
+		{Size{Value: 51, Units: "minutes"}, music.RunningTime},
+		{[]string{"075597991621"}, music.UPCList.Element},
+
+		{"DVD", dvd.ProductGroup},
+		{[]string{"Keanu Reeves", "Laurence Fishburne", "Carrie-Anne Moss"}, dvd.Actor},
+		{[]string{"Lana Wachowski", "Lilly Wachowski"}, dvd.Director},
+		{[]string{"Closed-captioned", "Color", "Widescreen"}, dvd.Format},
+		{"Dolby Digital 5.1", dvd.Languages.Language[0].AudioFormat},
+		{"2.35:1", dvd.AspectRatio},
+		{"R (Restricted)", dvd.AudienceRating},
+		{"DVD", dvd.MediaType},
+		{2, dvd.NumberOfDiscs},
+		{[]string{"Anamorphic Widescreen"}, dvd.PictureFormat},
+		{"1", dvd.RegionCode},
+
+		{"Video Games", game.ProductGroup},
+		{"Nintendo", game.Brand},
+		{"A", game.CEROAgeRating},
+		{"Everyone 10+", game.ESRBAgeRating},
+		{2, len(game.Feature)},
+		{"Nintendo Switch", game.HardwareType},
+		{"HACPAAAAA", game.Model},
+		{"Nintendo Switch", game.OperatingSystem},
+		{[]string{"Nintendo Switch"}, game.Platform},
+		{"Games", game.ProductTypeSubcategory},
+		{NewMoney(5999, CurrencyUSD), game.ListPrice.Money},
+
+		{"Apparel", apparel.ProductGroup},
+		{"32W x 32L", apparel.ClothingSize},
+		{"Dark Stonewash", apparel.Color},
+		{"mens", apparel.Department},
+		{3, len(apparel.Feature)},
+		{"32W x 32L", apparel.Size},
+
+		{"Shoes", shoes.ProductGroup},
+		{"M9160", shoes.ItemPartNumber},
+		{"M9160", shoes.PartNumber},
+		{"9 D(M) US", shoes.ShoeSize},
+
+		{"Digital Video Player", electronics.ProductGroup},
+		{[]string{"CE"}, electronics.Category},
+		{"Unknown", electronics.HazardousMaterialType},
+		{false, electronics.IsAutographed},
+		{false, electronics.IsMemorabilia},
+		{"Requires a compatible HDTV with an HDMI port.", electronics.LegalDisclaimer},
+		{"90-day limited warranty", electronics.ManufacturerPartsWarrantyDescription},
+		{2016, electronics.ModelYear},
+		{"W87CUN", electronics.MPN},
+		{Size{Value: 460, Units: "hundredths-inches"}, electronics.PackageDimensions.Length},
+		{"B00ZV9RDKK-FTV", electronics.SKU},
+		{"90-day limited warranty", electronics.Warranty},
+
+		{"Toy", toy.ProductGroup},
+		{DecimalWithUnits{Value: 48, Units: "months"}, toy.ManufacturerMinimumAge},
+		{DecimalWithUnits{Value: 1200, Units: "months"}, toy.ManufacturerMaximumAge},
+		{484, toy.NumberOfItems},
+
+		{"Magazine", magazine.ProductGroup},
+		{"12", magazine.IssuesPerYear},
+		{"Technology", magazine.MagazineType},
+		{12, magazine.NumberOfIssues},
+		{Size{Value: 12, Units: "months"}, magazine.SubscriptionLength},
+
+		{"Movie", video.ProductGroup},
+		{[]string{"Bryan Cranston"}, video.Actor},
+		{"1", video.EpisodeSequence},
+		{"BB-0001", video.SeikodoProductCode},
+		{"1", video.TrackSequence},
+	} {
+		test.DeepEqual(t)
+	}
+}
+
+func unmarshalItemLookupFixture(t *testing.T, name string) ItemLookupResponse {
+	data, _ := ioutil.ReadFile("_fixtures/" + name)
+	res := ItemLookupResponse{}
+	if err := xml.Unmarshal(data, &res); err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	return res
+}
+
+func TestUnmarshalInvalidPrice(t *testing.T) {
+	items := unmarshalItemLookupFixture(t, "ItemLookupInvalidPrice.xml").Items.Item
+	Test{2, len(items)}.Compare(t)
+	listPrice := items[0].ItemAttributes.ListPrice
+	_, err := listPrice.ParsedMoney()
+	for _, test := range []Test{
+		{"プログラミング言語Go", items[0].ItemAttributes.Title},
+		{"N/A", listPrice.Amount},
+		{"JPY", listPrice.CurrencyCode},
+		{Money{}, listPrice.Money},
+		{"Invalid Price: Invalid amount N/A", err.Error()},
+		{NewMoney(3996, CurrencyJPY), items[0].OfferSummary.LowestNewPrice.Money},
+		{"Go言語による並行処理", items[1].ItemAttributes.Title},
+		{NewMoney(3888, CurrencyJPY), items[1].ItemAttributes.ListPrice.Money},
+	} {
+		test.Compare(t)
+	}
+}
+
+func TestUnmarshalEditorialReviews(t *testing.T) {
+	reviews := unmarshalItemLookupFixture(t, "ItemLookupEditorialReview.xml").Items.Item[0].EditorialReviews.EditorialReview
+	for _, test := range []Test{
+		{2, len(reviews)},
+		{"内容紹介", reviews[0].Source},
+		{"<b>Go言語</b>の聖典、待望の翻訳。", reviews[0].Content},
+		{false, reviews[0].IsLinkSuppressed},
+		{"著者について", reviews[1].Source},
+		{true, reviews[1].IsLinkSuppressed},
+	} {
+		test.Compare(t)
+	}
+}
+
+func TestUnmarshalTracks(t *testing.T) {
+	discs := unmarshalItemLookupFixture(t, "ItemLookupTracks.xml").Items.Item[0].Tracks.Disc
+	for _, test := range []Test{
+		{2, len(discs)},
+		{1, discs[0].Number},
+		{3, len(discs[0].Track)},
+		{Track{Number: 1, Title: "Different Trains: America - Before the War"}, discs[0].Track[0]},
+		{Track{Number: 3, Title: "Different Trains: After the War"}, discs[0].Track[2]},
+		{2, discs[1].Number},
+		{Track{Number: 2, Title: "Electric Counterpoint: Slow"}, discs[1].Track[1]},
+	} {
+		test.DeepEqual(t)
+	}
+}
+
+func TestUnmarshalSubjects(t *testing.T) {
+	item := unmarshalItemLookupFixture(t, "ItemLookupSubjects.xml").Items.Item[0]
+	Test{[]string{
+		"Go (Computer program language)",
+		"Computer programming",
+		"Open source software",
+	}, item.Subjects.Subject}.DeepEqual(t)
+}
+
+func TestUnmarshalVariations(t *testing.T) {
+	parent := unmarshalItemLookupFixture(t, "ItemLookupVariations.xml").Items.Item[0]
+	for _, test := range []Test{
+		{"B01M0EKQR2", parent.ParentASIN},
+		{NewMoney(3995, CurrencyUSD), parent.VariationSummary.LowestPrice.Money},
+		{NewMoney(6950, CurrencyUSD), parent.VariationSummary.HighestPrice.Money},
+		{NewMoney(2999, CurrencyUSD), parent.VariationSummary.LowestSalePrice.Money},
+		{"", parent.VariationSummary.HighestSalePrice.Amount},
+		{12, parent.Variations.TotalVariations},
+		{2, parent.Variations.TotalVariationPages},
+		{[]string{"Size", "Color"}, parent.Variations.VariationDimensions.VariationDimension},
+		{2, len(parent.Variations.Item)},
+		{"B01M0EKR2B", parent.Variations.Item[1].ASIN},
+		{"B01M0EKQR2", parent.Variations.Item[1].ParentASIN},
+		{"Black", parent.Variations.Item[1].ItemAttributes.Color},
+		{[]VariationAttribute{{Name: "Size", Value: "34W x 32L"}, {Name: "Color", Value: "Black"}}, parent.Variations.Item[1].VariationAttributes.VariationAttribute},
+		{"34W x 32L", parent.Variations.Item[1].VariationAttributes.Value("Size")},
+		{"", parent.Variations.Item[1].VariationAttributes.Value("Style")},
+	} {
+		test.DeepEqual(t)
+	}
+}
+
+func TestUnmarshalRelatedItems(t *testing.T) {
+	item := unmarshalItemLookupFixture(t, "ItemLookupRelatedItems.xml").Items.Item[0]
+	episodes := item.RelatedItemsOf(RelationshipTypeEpisode)
+	season := item.RelatedItemsOf(RelationshipTypeSeason)
+	for _, test := range []Test{
+		{2, len(item.RelatedItems)},
+		{RelationshipChildren, episodes.Relationship},
+		{RelationshipTypeEpisode, episodes.RelationshipType},
+		{7, episodes.RelatedItemCount},
+		{1, episodes.RelatedItemPageCount},
+		{1, episodes.RelatedItemPage},
+		{2, len(episodes.RelatedItem)},
+		{"B00LMR2AHK", episodes.RelatedItem[1].Item.ASIN},
+		{"2", episodes.RelatedItem[1].Item.ItemAttributes.EpisodeSequence},
+		{RelationshipParents, season.Relationship},
+		{"Breaking Bad", season.RelatedItem[0].Item.ItemAttributes.Title},
+		{true, item.RelatedItemsOf(RelationshipTypeTracks) == nil},
+	} {
+		test.Compare(t)
+	}
+}
@@ -0,0 +1,337 @@
+package amazon
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Currency is ISO 4217 alphabetic currency code
+type Currency string
+
+const (
+	// CurrencyAED UAE Dirham
+	CurrencyAED Currency = "AED"
+	// CurrencyAUD Australian Dollar
+	CurrencyAUD Currency = "AUD"
+	// CurrencyBRL Brazilian Real
+	CurrencyBRL Currency = "BRL"
+	// CurrencyCAD Canadian Dollar
+	CurrencyCAD Currency = "CAD"
+	// CurrencyCNY Yuan Renminbi
+	CurrencyCNY Currency = "CNY"
+	// CurrencyEGP Egyptian Pound
+	CurrencyEGP Currency = "EGP"
+	// CurrencyEUR Euro
+	CurrencyEUR Currency = "EUR"
+	// CurrencyGBP Pound Sterling
+	CurrencyGBP Currency = "GBP"
+	// CurrencyINR Indian Rupee
+	CurrencyINR Currency = "INR"
+	// CurrencyJPY Yen
+	CurrencyJPY Currency = "JPY"
+	// CurrencyMXN Mexican Peso
+	CurrencyMXN Currency = "MXN"
+	// CurrencyPLN Zloty
+	CurrencyPLN Currency = "PLN"
+	// CurrencySAR Saudi Riyal
+	CurrencySAR Currency = "SAR"
+	// CurrencySEK Swedish Krona
+	CurrencySEK Currency = "SEK"
+	// CurrencySGD Singapore Dollar
+	CurrencySGD Currency = "SGD"
+	// CurrencyTRY Turkish Lira
+	CurrencyTRY Currency = "TRY"
+	// CurrencyUSD US Dollar
+	CurrencyUSD Currency = "USD"
+)
+
+// currencyInfo is ISO 4217 metadata of a currency
+type currencyInfo struct {
+	numeric  int
+	exponent int
+	symbol   string
+}
+
+var currencyInfoMap = map[Currency]currencyInfo{
+	CurrencyAED: {784, 2, "AED"},
+	CurrencyAUD: {36, 2, "$"},
+	CurrencyBRL: {986, 2, "R$"},
+	CurrencyCAD: {124, 2, "$"},
+	CurrencyCNY: {156, 2, "¥"},
+	CurrencyEGP: {818, 2, "EGP"},
+	CurrencyEUR: {978, 2, "€"},
+	CurrencyGBP: {826, 2, "£"},
+	CurrencyINR: {356, 2, "₹"},
+	CurrencyJPY: {392, 0, "￥"},
+	CurrencyMXN: {484, 2, "$"},
+	CurrencyPLN: {985, 2, "zł"},
+	CurrencySAR: {682, 2, "SAR"},
+	CurrencySEK: {752, 2, "kr"},
+	CurrencySGD: {702, 2, "$"},
+	CurrencyTRY: {949, 2, "₺"},
+	CurrencyUSD: {840, 2, "$"},
+}
+
+// IsValid returns the currency is known
+func (currency Currency) IsValid() bool {
+	_, ok := currencyInfoMap[currency]
+	return ok
+}
+
+// NumericCode returns ISO 4217 numeric code of the currency, or 0 if unknown
+func (currency Currency) NumericCode() int {
+	return currencyInfoMap[currency].numeric
+}
+
+// Exponent returns number of digits of the minor unit such as 2 for USD and 0 for JPY.
+// 2 is returned for unknown currencies
+func (currency Currency) Exponent() int {
+	if info, ok := currencyInfoMap[currency]; ok {
+		return info.exponent
+	}
+	return 2
+}
+
+// Symbol returns local symbol of the currency such as $, or the code if unknown
+func (currency Currency) Symbol() string {
+	if info, ok := currencyInfoMap[currency]; ok {
+		return info.symbol
+	}
+	return string(currency)
+}
+
+// Money is amount of money in the minor unit of the currency, as Amount of Price is
+type Money struct {
+	// Amount is amount in the minor unit such as cents of USD
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney returns Money of the amount in the minor unit of the currency
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney returns Money of amount in the minor unit such as Amount of Price
+func ParseMoney(amount string, currency string) (Money, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(amount), 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("Invalid amount %v", amount)
+	}
+	return Money{Amount: n, Currency: Currency(currency)}, nil
+}
+
+// ParseDecimal returns Money of decimal amount in the major unit such as 19.99 for USD.
+// Digits below the minor unit of the currency are not allowed
+func ParseDecimal(amount string, currency Currency) (Money, error) {
+	s := strings.TrimSpace(amount)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	integer, fraction := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+	exponent := currency.Exponent()
+	if integer == "" || len(fraction) > exponent || !isDigits(integer) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("Invalid amount %v for %v", amount, currency)
+	}
+	n, err := strconv.ParseInt(integer+fraction+strings.Repeat("0", exponent-len(fraction)), 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("Invalid amount %v for %v", amount, currency)
+	}
+	if negative {
+		n = -n
+	}
+	return Money{Amount: n, Currency: currency}, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// IsZero returns the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Neg returns negated money
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Mul returns money multiplied by n such as price of quantity
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.Currency != other.Currency {
+		return fmt.Errorf("Currency mismatch %v and %v", m.Currency, other.Currency)
+	}
+	return nil
+}
+
+// Add returns sum of the money. Currencies must be same
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Sub returns difference of the money. Currencies must be same
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}, nil
+}
+
+// Cmp returns -1, 0 or 1 if m is less than, equal to or greater than other. Currencies must be same
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < other.Amount:
+		return -1, nil
+	case m.Amount > other.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Decimal returns amount in the major unit such as 19.99 for 1999 USD
+func (m Money) Decimal() string {
+	return m.format(".", "")
+}
+
+// String returns decimal amount followed by the currency code such as 19.99 USD
+func (m Money) String() string {
+	return m.Decimal() + " " + string(m.Currency)
+}
+
+// format returns decimal amount with the separators
+func (m Money) format(decimal string, group string) string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	digits := strconv.FormatInt(amount, 10)
+	exponent := m.Currency.Exponent()
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	integer, fraction := digits[:len(digits)-exponent], digits[len(digits)-exponent:]
+	if group != "" {
+		var b strings.Builder
+		for i, c := range integer {
+			if i > 0 && (len(integer)-i)%3 == 0 {
+				b.WriteString(group)
+			}
+			b.WriteRune(c)
+		}
+		integer = b.String()
+	}
+	if fraction == "" {
+		return sign + integer
+	}
+	return sign + integer + decimal + fraction
+}
+
+// localeFormat is number and currency format of a locale
+type localeFormat struct {
+	decimal string
+	group   string
+	// suffix is whether the symbol follows the amount
+	suffix bool
+	space  string
+}
+
+var localeFormatMap = map[string]localeFormat{
+	"en":    {".", ",", false, ""},
+	"ja":    {".", ",", false, ""},
+	"zh":    {".", ",", false, ""},
+	"es-MX": {".", ",", false, ""},
+	"pt":    {",", ".", false, "\u00a0"},
+	"tr":    {",", ".", false, ""},
+	"de":    {",", ".", true, "\u00a0"},
+	"es":    {",", ".", true, "\u00a0"},
+	"it":    {",", ".", true, "\u00a0"},
+	"nl":    {",", ".", false, "\u00a0"},
+	"fr":    {",", "\u202f", true, "\u00a0"},
+	"pl":    {",", "\u00a0", true, "\u00a0"},
+	"sv":    {",", "\u00a0", true, "\u00a0"},
+	"ar":    {".", ",", false, "\u00a0"},
+}
+
+// Format returns amount formatted with symbol of the currency in conventions of the locale such as en-US or ja-JP.
+// Conventions of the language are used if the locale is not known, and en otherwise
+func (m Money) Format(locale string) string {
+	f, ok := localeFormatMap[locale]
+	if !ok {
+		language := locale
+		if i := strings.IndexAny(locale, "-_"); i >= 0 {
+			language = locale[:i]
+		}
+		if f, ok = localeFormatMap[language]; !ok {
+			f = localeFormatMap["en"]
+		}
+	}
+	symbol := m.Currency.Symbol()
+	space := f.space
+	if len(symbol) == len(m.Currency) && symbol == string(m.Currency) {
+		space = "\u00a0"
+	}
+	amount := m.format(f.decimal, f.group)
+	if f.suffix {
+		return amount + space + symbol
+	}
+	if strings.HasPrefix(amount, "-") {
+		return "-" + symbol + space + amount[1:]
+	}
+	return symbol + space + amount
+}
+
+// NewPrice returns Price of the money, formatted in en conventions
+func NewPrice(m Money) Price {
+	return Price{
+		Amount:         strconv.FormatInt(m.Amount, 10),
+		CurrencyCode:   string(m.Currency),
+		FormattedPrice: m.Format("en"),
+		Money:          m,
+	}
+}
+
+// UnmarshalXML decodes Price and parses Amount and CurrencyCode into Money.
+// Money is left zero if Amount is malformed, see ParsedMoney for the error
+func (p *Price) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type price Price
+	var v price
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*p = Price(v)
+	p.Money, _ = p.ParsedMoney()
+	return nil
+}
+
+// ParsedMoney parses Amount and CurrencyCode into Money, returning error if Amount is malformed
+func (p Price) ParsedMoney() (Money, error) {
+	if p.Amount == "" {
+		return Money{Currency: Currency(p.CurrencyCode)}, nil
+	}
+	m, err := ParseMoney(p.Amount, p.CurrencyCode)
+	if err != nil {
+		return Money{}, fmt.Errorf("Invalid Price: %v", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,145 @@
+package amazon
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestCurrency(t *testing.T) {
+	for _, test := range []Test{
+		{2, CurrencyUSD.Exponent()},
+		{0, CurrencyJPY.Exponent()},
+		{2, Currency("XXX").Exponent()},
+		{392, CurrencyJPY.NumericCode()},
+		{978, CurrencyEUR.NumericCode()},
+		{0, Currency("XXX").NumericCode()},
+		{"£", CurrencyGBP.Symbol()},
+		{"XXX", Currency("XXX").Symbol()},
+		{true, CurrencyTRY.IsValid()},
+		{false, Currency("XXX").IsValid()},
+	} {
+		test.Compare(t)
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	m, err := ParseMoney("1999", "USD")
+	Test{nil, err}.Compare(t)
+	Test{NewMoney(1999, CurrencyUSD), m}.Compare(t)
+	_, err = ParseMoney("19.99", "USD")
+	Test{"Invalid amount 19.99", err.Error()}.Compare(t)
+}
+
+func TestParseDecimal(t *testing.T) {
+	for s, expected := range map[string]Money{
+		"19.99": NewMoney(1999, CurrencyUSD),
+		"19.9":  NewMoney(1990, CurrencyUSD),
+		"19":    NewMoney(1900, CurrencyUSD),
+		"-0.5":  NewMoney(-50, CurrencyUSD),
+	} {
+		m, err := ParseDecimal(s, CurrencyUSD)
+		Test{nil, err}.Compare(t)
+		Test{expected, m}.Compare(t)
+	}
+	m, err := ParseDecimal("4104", CurrencyJPY)
+	Test{nil, err}.Compare(t)
+	Test{NewMoney(4104, CurrencyJPY), m}.Compare(t)
+	for _, test := range []struct {
+		amount   string
+		currency Currency
+	}{
+		{"19.999", CurrencyUSD},
+		{"4104.5", CurrencyJPY},
+		{"1,000", CurrencyUSD},
+		{".5", CurrencyUSD},
+		{"", CurrencyUSD},
+	} {
+		_, err := ParseDecimal(test.amount, test.currency)
+		Test{"Invalid amount " + test.amount + " for " + string(test.currency), err.Error()}.Compare(t)
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	a, b := NewMoney(1999, CurrencyUSD), NewMoney(1, CurrencyUSD)
+	sum, err := a.Add(b)
+	Test{nil, err}.Compare(t)
+	Test{NewMoney(2000, CurrencyUSD), sum}.Compare(t)
+	diff, err := b.Sub(a)
+	Test{nil, err}.Compare(t)
+	Test{NewMoney(-1998, CurrencyUSD), diff}.Compare(t)
+	Test{NewMoney(5997, CurrencyUSD), a.Mul(3)}.Compare(t)
+	Test{NewMoney(-1999, CurrencyUSD), a.Neg()}.Compare(t)
+	Test{true, NewMoney(0, CurrencyJPY).IsZero()}.Compare(t)
+
+	for _, test := range []struct {
+		a, b     Money
+		expected int
+	}{
+		{a, b, 1},
+		{b, a, -1},
+		{a, a, 0},
+	} {
+		cmp, err := test.a.Cmp(test.b)
+		Test{nil, err}.Compare(t)
+		Test{test.expected, cmp}.Compare(t)
+	}
+
+	_, err = a.Add(NewMoney(100, CurrencyJPY))
+	Test{"Currency mismatch USD and JPY", err.Error()}.Compare(t)
+	_, err = a.Sub(NewMoney(100, CurrencyJPY))
+	Test{"Currency mismatch USD and JPY", err.Error()}.Compare(t)
+	_, err = a.Cmp(NewMoney(100, CurrencyJPY))
+	Test{"Currency mismatch USD and JPY", err.Error()}.Compare(t)
+}
+
+func TestMoneyFormat(t *testing.T) {
+	for _, test := range []Test{
+		{"19.99", NewMoney(1999, CurrencyUSD).Decimal()},
+		{"0.05", NewMoney(5, CurrencyUSD).Decimal()},
+		{"-0.05", NewMoney(-5, CurrencyUSD).Decimal()},
+		{"4104", NewMoney(4104, CurrencyJPY).Decimal()},
+		{"19.99 USD", NewMoney(1999, CurrencyUSD).String()},
+		{"$1,234,567.89", NewMoney(123456789, CurrencyUSD).Format("en-US")},
+		{"-$19.99", NewMoney(-1999, CurrencyUSD).Format("en-US")},
+		{"￥4,104", NewMoney(4104, CurrencyJPY).Format(RegionJapan.Locale())},
+		{"£999.00", NewMoney(99900, CurrencyGBP).Format(RegionUK.Locale())},
+		{"1.234,56\u00a0€", NewMoney(123456, CurrencyEUR).Format(RegionGermany.Locale())},
+		{"1\u202f234,56\u00a0€", NewMoney(123456, CurrencyEUR).Format(RegionFrance.Locale())},
+		{"1\u00a0234,56\u00a0kr", NewMoney(123456, CurrencySEK).Format(RegionSweden.Locale())},
+		{"R$\u00a01.234,56", NewMoney(123456, CurrencyBRL).Format(RegionBrazil.Locale())},
+		{"$1,234.56", NewMoney(123456, CurrencyMXN).Format(RegionMexico.Locale())},
+		{"1.234,56\u00a0€", NewMoney(123456, CurrencyEUR).Format("es-ES")},
+		{"AED\u00a01,234.56", NewMoney(123456, CurrencyAED).Format(RegionUAE.Locale())},
+		{"SAR\u00a01,234.56", NewMoney(123456, CurrencySAR).Format(RegionSaudiArabia.Locale())},
+		{"$1,234.56", NewMoney(123456, CurrencyUSD).Format("xx-YY")},
+	} {
+		test.Compare(t)
+	}
+}
+
+func TestPriceUnmarshalXML(t *testing.T) {
+	var p Price
+	err := xml.Unmarshal([]byte("<ListPrice><Amount>1999</Amount><CurrencyCode>USD</CurrencyCode><FormattedPrice>$19.99</FormattedPrice></ListPrice>"), &p)
+	Test{nil, err}.Compare(t)
+	Test{Price{Amount: "1999", CurrencyCode: "USD", FormattedPrice: "$19.99", Money: NewMoney(1999, CurrencyUSD)}, p}.Compare(t)
+
+	p = Price{}
+	err = xml.Unmarshal([]byte("<ListPrice><CurrencyCode>JPY</CurrencyCode><FormattedPrice>Too low to display</FormattedPrice></ListPrice>"), &p)
+	Test{nil, err}.Compare(t)
+	Test{Money{Currency: CurrencyJPY}, p.Money}.Compare(t)
+
+	p = Price{}
+	err = xml.Unmarshal([]byte("<ListPrice><Amount>N/A</Amount><CurrencyCode>USD</CurrencyCode></ListPrice>"), &p)
+	Test{nil, err}.Compare(t)
+	Test{Price{Amount: "N/A", CurrencyCode: "USD"}, p}.Compare(t)
+	_, err = p.ParsedMoney()
+	Test{"Invalid Price: Invalid amount N/A", err.Error()}.Compare(t)
+
+	m, err := Price{Amount: "1999", CurrencyCode: "USD"}.ParsedMoney()
+	Test{nil, err}.Compare(t)
+	Test{NewMoney(1999, CurrencyUSD), m}.Compare(t)
+
+	data, err := xml.Marshal(NewPrice(NewMoney(1999, CurrencyUSD)))
+	Test{nil, err}.Compare(t)
+	Test{"<Price><Amount>1999</Amount><CurrencyCode>USD</CurrencyCode><FormattedPrice>$19.99</FormattedPrice></Price>", string(data)}.Compare(t)
+}
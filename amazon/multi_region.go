@@ -0,0 +1,196 @@
+package amazon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/credentials"
+)
+
+// RegionConfig represents credentials and associate tag of a region of MultiRegionClient
+type RegionConfig struct {
+	Region       Region
+	Credentials  credentials.Provider
+	AssociateTag string
+}
+
+// RegionError represents error of request to a region
+type RegionError struct {
+	Region Region
+	Err    error
+}
+
+func (e *RegionError) Error() string {
+	return fmt.Sprintf("Request to %v failed: %v", e.Region, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *RegionError) Unwrap() error {
+	return e.Err
+}
+
+// MultiRegionClient sends the same request to clients of regions concurrently
+//
+//	client, _ := amazon.NewMultiRegion([]amazon.RegionConfig{
+//		{Region: amazon.RegionJapan, Credentials: jp, AssociateTag: "ngsio-22"},
+//		{Region: amazon.RegionUS, Credentials: us, AssociateTag: "ngsio-20"},
+//	})
+//	result := client.ItemLookup(ctx, amazon.ItemLookupParameters{ItemIDs: []string{"4621300253"}})
+//	res, err := result.Responses[amazon.RegionJapan], result.Errors[amazon.RegionJapan]
+type MultiRegionClient struct {
+	// Clients are clients keyed by their Region
+	Clients map[Region]*Client
+	// MaxConcurrency is maximum number of regions requested concurrently. All regions are requested at once if not positive
+	MaxConcurrency int
+}
+
+// NewMultiRegion returns MultiRegionClient with a client for each config.
+// The options are applied to all clients, so that they can share HTTPClient, RateLimiter or Cache
+func NewMultiRegion(configs []RegionConfig, options ...Option) (*MultiRegionClient, error) {
+	if len(configs) == 0 {
+		return nil, errors.New("RegionConfigs are not specified")
+	}
+	m := &MultiRegionClient{Clients: map[Region]*Client{}}
+	for _, config := range configs {
+		if _, ok := m.Clients[config.Region]; ok {
+			return nil, fmt.Errorf("Duplicated Region %v", config.Region)
+		}
+		client, err := NewWithCredentials(config.Credentials, config.AssociateTag, config.Region, options...)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid RegionConfig for %v: %v", config.Region, err)
+		}
+		m.Clients[config.Region] = client
+	}
+	return m, nil
+}
+
+// NewMultiRegionFromClients returns MultiRegionClient of the clients keyed by their Region
+func NewMultiRegionFromClients(clients ...*Client) (*MultiRegionClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("Clients are not specified")
+	}
+	m := &MultiRegionClient{Clients: map[Region]*Client{}}
+	for _, client := range clients {
+		if _, ok := m.Clients[client.Region]; ok {
+			return nil, fmt.Errorf("Duplicated Region %v", client.Region)
+		}
+		m.Clients[client.Region] = client
+	}
+	return m, nil
+}
+
+// Regions returns regions of the clients in sorted order
+func (m *MultiRegionClient) Regions() []Region {
+	regions := make([]Region, 0, len(m.Clients))
+	for region := range m.Clients {
+		regions = append(regions, region)
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i] < regions[j] })
+	return regions
+}
+
+// fanOut calls fn with client of each region concurrently up to MaxConcurrency and returns errors keyed by region
+func (m *MultiRegionClient) fanOut(fn func(region Region, client *Client) error) map[Region]*RegionError {
+	n := m.MaxConcurrency
+	if n <= 0 {
+		n = len(m.Clients)
+	}
+	sem := make(chan struct{}, n)
+	errs := map[Region]*RegionError{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for region, client := range m.Clients {
+		wg.Add(1)
+		go func(region Region, client *Client) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if err := fn(region, client); err != nil {
+				mu.Lock()
+				errs[region] = &RegionError{Region: region, Err: err}
+				mu.Unlock()
+			}
+		}(region, client)
+	}
+	wg.Wait()
+	return errs
+}
+
+// firstRegionError returns error of the first region in sorted order, or nil if no errors
+func firstRegionError(errs map[Region]*RegionError) error {
+	var first *RegionError
+	for region, e := range errs {
+		if first == nil || region < first.Region {
+			first = e
+		}
+	}
+	if first == nil {
+		return nil
+	}
+	return first
+}
+
+// MultiRegionItemLookupResult represents results of ItemLookup requests to regions
+type MultiRegionItemLookupResult struct {
+	// Responses are responses of succeeded regions
+	Responses map[Region]*ItemLookupResponse
+	// Errors are errors of failed regions
+	Errors map[Region]*RegionError
+}
+
+// Err returns error of the first failed region in sorted order, or nil if all regions succeeded
+func (result *MultiRegionItemLookupResult) Err() error {
+	return firstRegionError(result.Errors)
+}
+
+// ItemLookup sends ItemLookup with the parameters to all regions concurrently.
+// AssociateTag of the parameters should be empty so that associate tag of each client is used
+func (m *MultiRegionClient) ItemLookup(ctx context.Context, parameters ItemLookupParameters) *MultiRegionItemLookupResult {
+	result := &MultiRegionItemLookupResult{Responses: map[Region]*ItemLookupResponse{}}
+	var mu sync.Mutex
+	result.Errors = m.fanOut(func(region Region, client *Client) error {
+		res, err := client.ItemLookup(parameters).DoContext(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		result.Responses[region] = res
+		mu.Unlock()
+		return nil
+	})
+	return result
+}
+
+// MultiRegionItemSearchResult represents results of ItemSearch requests to regions
+type MultiRegionItemSearchResult struct {
+	// Responses are responses of succeeded regions
+	Responses map[Region]*ItemSearchResponse
+	// Errors are errors of failed regions
+	Errors map[Region]*RegionError
+}
+
+// Err returns error of the first failed region in sorted order, or nil if all regions succeeded
+func (result *MultiRegionItemSearchResult) Err() error {
+	return firstRegionError(result.Errors)
+}
+
+// ItemSearch sends ItemSearch with the parameters to all regions concurrently.
+// AssociateTag of the parameters should be empty so that associate tag of each client is used
+func (m *MultiRegionClient) ItemSearch(ctx context.Context, parameters ItemSearchParameters) *MultiRegionItemSearchResult {
+	result := &MultiRegionItemSearchResult{Responses: map[Region]*ItemSearchResponse{}}
+	var mu sync.Mutex
+	result.Errors = m.fanOut(func(region Region, client *Client) error {
+		res, err := client.ItemSearch(parameters).DoContext(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		result.Responses[region] = res
+		mu.Unlock()
+		return nil
+	})
+	return result
+}
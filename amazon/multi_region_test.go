@@ -0,0 +1,113 @@
+package amazon
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/credentials"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+// mockRegion mocks the operation of the host for the access key and associate tag of the region
+func mockRegion(host, operation, accessKeyID, associateTag string) *gock.Response {
+	return gock.New("https://" + host).
+		Get("/onca/xml").
+		MatchParams(map[string]string{
+			"Operation":      "^" + operation + "$",
+			"AWSAccessKeyId": "^" + accessKeyID + "$",
+			"AssociateTag":   "^" + associateTag + "$",
+		}).
+		Reply(200)
+}
+
+func newTestMultiRegion(t *testing.T) *MultiRegionClient {
+	m, err := NewMultiRegion([]RegionConfig{
+		{Region: RegionJapan, Credentials: credentials.NewStatic("AKJP", "SK", ""), AssociateTag: "ngsio-22"},
+		{Region: RegionUS, Credentials: credentials.NewStatic("AKUS", "SK", ""), AssociateTag: "ngsio-20"},
+		{Region: RegionUK, Credentials: credentials.NewStatic("AKUK", "SK", ""), AssociateTag: "ngsio-21"},
+	}, WithRateLimiter(nil))
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	return m
+}
+
+func TestNewMultiRegion(t *testing.T) {
+	m := newTestMultiRegion(t)
+	Test{[]Region{RegionJapan, RegionUK, RegionUS}, m.Regions()}.DeepEqual(t)
+	Test{"ngsio-20", m.Clients[RegionUS].AssociateTag}.Compare(t)
+
+	_, err := NewMultiRegion(nil)
+	Test{"RegionConfigs are not specified", err.Error()}.Compare(t)
+	_, err = NewMultiRegion([]RegionConfig{
+		{Region: RegionJapan, Credentials: credentials.NewStatic("AK", "SK", ""), AssociateTag: "ngsio-22"},
+		{Region: RegionJapan, Credentials: credentials.NewStatic("AK", "SK", ""), AssociateTag: "ngsio-22"},
+	})
+	Test{"Duplicated Region JP", err.Error()}.Compare(t)
+	_, err = NewMultiRegion([]RegionConfig{{Region: RegionUS, Credentials: credentials.NewStatic("AK", "SK", "")}})
+	Test{"Invalid RegionConfig for US: AssociateTag is not specified", err.Error()}.Compare(t)
+}
+
+func TestNewMultiRegionFromClients(t *testing.T) {
+	jp, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	us, _ := New("AK", "SK", "ngsio-20", RegionUS)
+	m, err := NewMultiRegionFromClients(jp, us)
+	Test{nil, err}.Compare(t)
+	Test{jp, m.Clients[RegionJapan]}.Compare(t)
+	Test{us, m.Clients[RegionUS]}.Compare(t)
+
+	_, err = NewMultiRegionFromClients(jp, jp)
+	Test{"Duplicated Region JP", err.Error()}.Compare(t)
+	_, err = NewMultiRegionFromClients()
+	Test{"Clients are not specified", err.Error()}.Compare(t)
+}
+
+func TestMultiRegionItemLookup(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	mockRegion("webservices.amazon.co.jp", "ItemLookup", "AKJP", "ngsio-22").File("_fixtures/ItemLookup.xml")
+	mockRegion("webservices.amazon.com", "ItemLookup", "AKUS", "ngsio-20").File("_fixtures/ItemLookupItemAttributes.xml")
+	mockRegion("webservices.amazon.co.uk", "ItemLookup", "AKUK", "ngsio-21").
+		Status(503).
+		BodyString("<html>Service Unavailable</html>")
+	m := newTestMultiRegion(t)
+	result := m.ItemLookup(context.Background(), ItemLookupParameters{ItemIDs: []string{"4621300253"}})
+	Test{true, gock.IsDone()}.Compare(t)
+	Test{2, len(result.Responses)}.Compare(t)
+	Test{"477418392X", result.Responses[RegionJapan].Items.Item[0].ASIN}.Compare(t)
+	Test{"0134190440", result.Responses[RegionUS].Items.Item[0].ASIN}.Compare(t)
+	Test{1, len(result.Errors)}.Compare(t)
+	Test{RegionUK, result.Errors[RegionUK].Region}.Compare(t)
+	Test{true, strings.HasPrefix(result.Err().Error(), "Request to UK failed: ")}.Compare(t)
+	var httpErr *HTTPError
+	Test{true, errors.As(result.Err(), &httpErr)}.Compare(t)
+}
+
+func TestMultiRegionItemSearch(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	mockRegion("webservices.amazon.co.jp", "ItemSearch", "AKJP", "ngsio-22").File("_fixtures/ItemSearch.xml")
+	mockRegion("webservices.amazon.com", "ItemSearch", "AKUS", "ngsio-20").File("_fixtures/ItemSearch2.xml")
+	mockRegion("webservices.amazon.co.uk", "ItemSearch", "AKUK", "ngsio-21").File("_fixtures/ItemSearchLastPage.xml")
+	m := newTestMultiRegion(t)
+	m.MaxConcurrency = 1
+	result := m.ItemSearch(context.Background(), ItemSearchParameters{Keywords: "Go"})
+	Test{nil, result.Err()}.Compare(t)
+	Test{true, gock.IsDone()}.Compare(t)
+	Test{3, len(result.Responses)}.Compare(t)
+	Test{190, result.Responses[RegionJapan].Items.TotalResults}.Compare(t)
+	Test{431, result.Responses[RegionUS].Items.TotalResults}.Compare(t)
+	Test{25, result.Responses[RegionUK].Items.TotalResults}.Compare(t)
+}
+
+func TestMultiRegionCanceled(t *testing.T) {
+	defer gock.Off()
+	gock.DisableNetworking()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := newTestMultiRegion(t).ItemSearch(ctx, ItemSearchParameters{Keywords: "Go"})
+	Test{3, len(result.Errors)}.Compare(t)
+	Test{true, errors.Is(result.Err(), context.Canceled)}.Compare(t)
+}
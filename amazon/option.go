@@ -0,0 +1,94 @@
+package amazon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures Client on New
+type Option func(*Client) error
+
+// WithHTTPClient sets HTTPDoer that sends HTTP requests
+func WithHTTPClient(doer HTTPDoer) Option {
+	return func(client *Client) error {
+		if doer == nil {
+			return errors.New("HTTPClient is not specified")
+		}
+		client.HTTPClient = doer
+		return nil
+	}
+}
+
+// WithTransport sets http.RoundTripper used by the HTTP client
+func WithTransport(transport http.RoundTripper) Option {
+	return func(client *Client) error {
+		if transport == nil {
+			return errors.New("Transport is not specified")
+		}
+		client.HTTPClient = &http.Client{Transport: transport}
+		return nil
+	}
+}
+
+// WithTimeout sets time limit for each HTTP request
+func WithTimeout(timeout time.Duration) Option {
+	return func(client *Client) error {
+		if timeout < 0 {
+			return errors.New("Timeout must not be negative")
+		}
+		httpClient, ok := client.HTTPClient.(*http.Client)
+		if !ok {
+			if client.HTTPClient != nil {
+				return errors.New("Timeout is only configurable with *http.Client")
+			}
+			httpClient = &http.Client{}
+		}
+		c := *httpClient
+		c.Timeout = timeout
+		client.HTTPClient = &c
+		return nil
+	}
+}
+
+// WithEndpoint overrides API endpoint of the region with the base URL such as http://localhost:8080/onca/xml.
+// Requests are sent to and signed with the host and path of the URL.
+// Path defaults to / if empty
+func WithEndpoint(endpoint string) Option {
+	return func(client *Client) error {
+		if endpoint == "" {
+			return errors.New("Endpoint is not specified")
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return fmt.Errorf("Invalid Endpoint %v: %v", endpoint, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("Invalid Endpoint %v: scheme must be http or https", endpoint)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("Invalid Endpoint %v: host is not specified", endpoint)
+		}
+		if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+			return fmt.Errorf("Invalid Endpoint %v: userinfo, query and fragment are not allowed", endpoint)
+		}
+		if u.Path == "" {
+			u.Path = "/"
+		}
+		client.EndpointURL = u.String()
+		return nil
+	}
+}
+
+// WithAssociateTagRouter sets AssociateTagRouter choosing associate tag of each request
+func WithAssociateTagRouter(router AssociateTagRouter) Option {
+	return func(client *Client) error {
+		if router == nil {
+			return errors.New("AssociateTagRouter is not specified")
+		}
+		client.AssociateTagRouter = router
+		return nil
+	}
+}
@@ -0,0 +1,138 @@
+package amazon
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mockDoer struct {
+	requests []*http.Request
+	body     string
+}
+
+func (doer *mockDoer) Do(req *http.Request) (*http.Response, error) {
+	doer.requests = append(doer.requests, req)
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(doer.body)),
+		Request:    req,
+	}, nil
+}
+
+type mockRoundTripper struct {
+	mockDoer
+}
+
+func (rt *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return rt.Do(req)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	doer := &mockDoer{body: "<mock><result>OK</result></mock>"}
+	client, err := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(nil))
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	for _, method := range []string{"GET", "POST"} {
+		mockResp := mockResponse{}
+		if _, err := client.DoRequest(&mockOperation{method: method}, &mockResp); err != nil {
+			t.Errorf("Expected nil but got %v", err)
+		}
+		Test{"OK", mockResp.Result}.Compare(t)
+	}
+	Test{2, len(doer.requests)}.Compare(t)
+	Test{"https://webservices.amazon.co.jp/onca/xml?" + expectedGetBody, doer.requests[0].URL.String()}.Compare(t)
+	Test{"https://webservices.amazon.co.jp/onca/xml", doer.requests[1].URL.String()}.Compare(t)
+	Test{"application/x-www-form-urlencoded", doer.requests[1].Header.Get("Content-Type")}.Compare(t)
+	body, _ := ioutil.ReadAll(doer.requests[1].Body)
+	Test{expectedPostBody, string(body)}.Compare(t)
+}
+
+func TestWithHTTPClientNil(t *testing.T) {
+	client, err := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(nil))
+	Test{"HTTPClient is not specified", err.Error()}.Compare(t)
+	if client != nil {
+		t.Errorf(`Expected nil but got "%v"`, client)
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	rt := &mockRoundTripper{mockDoer{body: "<mock><result>OK</result></mock>"}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithTransport(rt), WithRateLimiter(nil))
+	mockResp := mockResponse{}
+	if _, err := client.DoRequest(&mockOperation{}, &mockResp); err != nil {
+		t.Errorf("Expected nil but got %v", err)
+	}
+	Test{1, len(rt.requests)}.Compare(t)
+	Test{"OK", mockResp.Result}.Compare(t)
+	_, err := New("AK", "SK", "ngsio-22", RegionJapan, WithTransport(nil), WithRateLimiter(nil))
+	Test{"Transport is not specified", err.Error()}.Compare(t)
+}
+
+func TestWithTimeout(t *testing.T) {
+	rt := &mockRoundTripper{}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithTransport(rt), WithTimeout(3*time.Second))
+	httpClient := client.HTTPClient.(*http.Client)
+	Test{3 * time.Second, httpClient.Timeout}.Compare(t)
+	Test{http.RoundTripper(rt), httpClient.Transport}.Compare(t)
+	client, _ = New("AK", "SK", "ngsio-22", RegionJapan, WithTimeout(time.Second))
+	Test{time.Second, client.HTTPClient.(*http.Client).Timeout}.Compare(t)
+	_, err := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(&mockDoer{}), WithTimeout(time.Second))
+	Test{"Timeout is only configurable with *http.Client", err.Error()}.Compare(t)
+	_, err = New("AK", "SK", "ngsio-22", RegionJapan, WithTimeout(-time.Second))
+	Test{"Timeout must not be negative", err.Error()}.Compare(t)
+}
+
+func TestWithEndpoint(t *testing.T) {
+	doer := &mockDoer{body: "<mock><result>OK</result></mock>"}
+	client, err := New("AK", "SK", "ngsio-22", RegionJapan, WithEndpoint("http://localhost:8080/proxy/onca/xml"), WithHTTPClient(doer), WithRateLimiter(nil))
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{"http://localhost:8080/proxy/onca/xml", client.Endpoint()}.Compare(t)
+	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	mockResp := mockResponse{}
+	if _, err := client.DoRequest(&mockOperation{}, &mockResp); err != nil {
+		t.Errorf("Expected nil but got %v", err)
+	}
+	Test{"OK", mockResp.Result}.Compare(t)
+	Test{"http://localhost:8080/proxy/onca/xml?" + strings.Replace(expectedGetBody,
+		"wHPsmXHNme%2B%2F1bb39wTxqB51YgB2xBRe2r5WOzfqViQ%3D", "jso3evuk5WmbDrxkHBA2pLzM3z9Vf5PopouWXGRQc8w%3D", 1),
+		doer.requests[0].URL.String()}.Compare(t)
+
+	client, _ = New("AK", "SK", "ngsio-22", RegionJapan, WithEndpoint("https://127.0.0.1:8443"), WithRateLimiter(nil))
+	Test{"https://127.0.0.1:8443/", client.Endpoint()}.Compare(t)
+}
+
+func TestWithEndpointInvalid(t *testing.T) {
+	for endpoint, expected := range map[string]string{
+		"":                         "Endpoint is not specified",
+		"localhost:8080/onca/xml":  "Invalid Endpoint localhost:8080/onca/xml: scheme must be http or https",
+		"ftp://localhost/onca/xml": "Invalid Endpoint ftp://localhost/onca/xml: scheme must be http or https",
+		"http:///onca/xml":         "Invalid Endpoint http:///onca/xml: host is not specified",
+		"http://localhost/?a=b":    "Invalid Endpoint http://localhost/?a=b: userinfo, query and fragment are not allowed",
+	} {
+		client, err := New("AK", "SK", "ngsio-22", RegionJapan, WithEndpoint(endpoint))
+		if err == nil {
+			t.Errorf("Expected error for %q but got nil", endpoint)
+			continue
+		}
+		Test{expected, err.Error()}.Compare(t)
+		if client != nil {
+			t.Errorf(`Expected nil but got "%v"`, client)
+		}
+	}
+}
+
+func TestWithAssociateTagRouter(t *testing.T) {
+	client, err := New("AK", "SK", "ngsio-22", RegionJapan, WithAssociateTagRouter(AssociateTagByRegion(map[Region]string{})))
+	Test{nil, err}.Compare(t)
+	Test{true, client.AssociateTagRouter != nil}.Compare(t)
+	_, err = New("AK", "SK", "ngsio-22", RegionJapan, WithAssociateTagRouter(nil))
+	Test{"AssociateTagRouter is not specified", err.Error()}.Compare(t)
+}
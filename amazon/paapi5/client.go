@@ -0,0 +1,232 @@
+// Package paapi5 is client of Product Advertising API 5.0, which sends JSON over POST signed with AWS Signature Version 4.
+//
+// It shares Region, Condition, HTTPDoer and Error with package amazon, the client of the legacy 2013-08-01 API.
+//
+//	client, _ := paapi5.New("AK", "SK", "ngsio-22", amazon.RegionJapan)
+//	res, err := client.GetItems(paapi5.GetItemsParameters{
+//		ItemIDs:   []string{"4621300253"},
+//		Resources: []paapi5.Resource{paapi5.ResourceItemInfoTitle},
+//	}).Do()
+package paapi5
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/credentials"
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/signer"
+)
+
+var timeNowFunc = time.Now
+
+const (
+	// Service is service name used to sign requests
+	Service = signer.ProductAdvertisingAPIService
+	// PartnerTypeAssociates is the only partner type of the API
+	PartnerTypeAssociates = "Associates"
+	// targetPrefix is prefix of X-Amz-Target header followed by the operation name
+	targetPrefix = "com.amazon.paapi5.v1.ProductAdvertisingAPIv1."
+)
+
+// operationRequest is implemented by requests of the operations
+type operationRequest interface {
+	operation() string
+	path() string
+	payload() interface{}
+}
+
+// Client is client of Product Advertising API 5.0
+type Client struct {
+	// AccessKeyID and SecretAccessKey sign requests if Credentials is nil
+	AccessKeyID     string
+	SecretAccessKey string
+	PartnerTag      string
+	Region          amazon.Region
+	// HTTPClient sends HTTP requests. http.DefaultClient is used if nil
+	HTTPClient amazon.HTTPDoer
+	// EndpointURL overrides https://<host of Region> if not empty. Set it with WithEndpoint
+	EndpointURL string
+	// Credentials provides keys on each signing. Set it with NewWithCredentials
+	Credentials credentials.Provider
+}
+
+// New returns new client
+func New(accessKeyID string, secretAccessKey string, partnerTag string, region amazon.Region, options ...Option) (*Client, error) {
+	if accessKeyID == "" {
+		return nil, errors.New("AccessKeyID is not specified")
+	}
+	if secretAccessKey == "" {
+		return nil, errors.New("SecretAccessKey is not specified")
+	}
+	return newClient(&Client{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		PartnerTag:      partnerTag,
+		Region:          region,
+	}, options)
+}
+
+// NewWithCredentials returns new client retrieving keys from the provider on each signing
+func NewWithCredentials(provider credentials.Provider, partnerTag string, region amazon.Region, options ...Option) (*Client, error) {
+	if provider == nil {
+		return nil, errors.New("Credentials is not specified")
+	}
+	return newClient(&Client{
+		Credentials: provider,
+		PartnerTag:  partnerTag,
+		Region:      region,
+	}, options)
+}
+
+func newClient(client *Client, options []Option) (*Client, error) {
+	if client.PartnerTag == "" {
+		return nil, errors.New("PartnerTag is not specified")
+	}
+	if client.Region == "" {
+		return nil, errors.New("Region is not specified")
+	}
+	if !IsSupported(client.Region) {
+		return nil, fmt.Errorf("Invalid Region %v", client.Region)
+	}
+	for _, option := range options {
+		if err := option(client); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// NewFromEnvironment returns new client from environment variables same as amazon.NewFromEnvionment
+func NewFromEnvironment(options ...Option) (*Client, error) {
+	return New(
+		os.Getenv("AWS_ACCESS_KEY_ID"),
+		os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		os.Getenv("AWS_ASSOCIATE_TAG"),
+		amazon.Region(os.Getenv("AWS_PRODUCT_REGION")),
+		options...,
+	)
+}
+
+// NewFromDefaultChain returns new client with credentials.NewDefaultChain same as amazon.NewFromDefaultChain
+func NewFromDefaultChain(options ...Option) (*Client, error) {
+	provider := credentials.NewDefaultChain()
+	if _, err := provider.Retrieve(context.Background()); err != nil {
+		return nil, err
+	}
+	return NewWithCredentials(provider, os.Getenv("AWS_ASSOCIATE_TAG"), amazon.Region(os.Getenv("AWS_PRODUCT_REGION")), options...)
+}
+
+// retrieveCredentials returns keys from Credentials, or AccessKeyID and SecretAccessKey if Credentials is nil
+func (client *Client) retrieveCredentials(ctx context.Context) (credentials.Value, error) {
+	if client.Credentials == nil {
+		return credentials.NewStatic(client.AccessKeyID, client.SecretAccessKey, "").Retrieve(ctx)
+	}
+	return client.Credentials.Retrieve(ctx)
+}
+
+// Endpoint returns base URL of the API
+func (client *Client) Endpoint() string {
+	if client.EndpointURL != "" {
+		return client.EndpointURL
+	}
+	return "https://" + Host(client.Region)
+}
+
+// Marketplace returns marketplace of the region
+func (client *Client) Marketplace() string {
+	return Marketplace(client.Region)
+}
+
+func (client *Client) httpClient() amazon.HTTPDoer {
+	if client.HTTPClient != nil {
+		return client.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// partner is common parameters of all operations
+type partner struct {
+	PartnerTag  string
+	PartnerType string
+	Marketplace string
+}
+
+func (client *Client) partner() partner {
+	return partner{
+		PartnerTag:  client.PartnerTag,
+		PartnerType: PartnerTypeAssociates,
+		Marketplace: client.Marketplace(),
+	}
+}
+
+// newRequest returns HTTP request of the operation signed with AWS Signature Version 4
+func (client *Client) newRequest(ctx context.Context, op operationRequest) (*http.Request, error) {
+	creds, err := client.retrieveCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(op.payload())
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", client.Endpoint()+op.path(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Encoding", "amz-1.0")
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("X-Amz-Target", targetPrefix+op.operation())
+	s := signer.Signer{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		Region:          AWSRegion(client.Region),
+		Service:         Service,
+	}
+	if _, err := s.SignV4(req, body, timeNowFunc()); err != nil {
+		return nil, err
+	}
+	return req.WithContext(ctx), nil
+}
+
+// doRequest sends request of the operation and decodes JSON response into responseObject.
+// Error responses are returned as *ErrorResponse, and other non-2xx responses as *amazon.HTTPError
+func (client *Client) doRequest(ctx context.Context, op operationRequest, responseObject interface{}) (*http.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	req, err := client.newRequest(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.httpClient().Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return res, err
+	}
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res, json.Unmarshal(data, responseObject)
+	}
+	if e := newErrorResponse(res, data); e != nil {
+		return res, e
+	}
+	if len(data) > maxHTTPErrorBodySize {
+		data = data[:maxHTTPErrorBodySize]
+	}
+	return res, &amazon.HTTPError{StatusCode: res.StatusCode, Header: res.Header, Body: data}
+}
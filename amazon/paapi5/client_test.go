@@ -0,0 +1,229 @@
+package paapi5
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/credentials"
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/signer"
+)
+
+type Test struct {
+	expected interface{}
+	actual   interface{}
+}
+
+func (test Test) Compare(t *testing.T) {
+	if test.expected != test.actual {
+		t.Errorf(`Expected "%v" but got "%v"`, test.expected, test.actual)
+	}
+}
+
+func (test Test) DeepEqual(t *testing.T) {
+	if !reflect.DeepEqual(test.expected, test.actual) {
+		t.Errorf(`Expected "%v" but got "%v"`, test.expected, test.actual)
+	}
+}
+
+func setNow(t time.Time) {
+	timeNowFunc = func() time.Time { return t }
+}
+
+type standInReply struct {
+	status int
+	body   string
+}
+
+// standInServer is local stand-in of PA-API 5.0 verifying signatures and replying canned responses
+type standInServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	replies  map[string]standInReply
+	payloads []map[string]interface{}
+	targets  []string
+}
+
+func newStandInServer(replies map[string]standInReply) *standInServer {
+	s := &standInServer{replies: replies}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *standInServer) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	signed, _ := http.NewRequest(r.Method, "http://"+r.Host+r.URL.RequestURI(), nil)
+	for _, name := range []string{"Content-Encoding", "Content-Type", "X-Amz-Target"} {
+		signed.Header.Set(name, r.Header.Get(name))
+	}
+	amzDate, _ := time.Parse(signer.AmzDateFormat, r.Header.Get("X-Amz-Date"))
+	s4 := signer.Signer{AccessKeyID: "AK", SecretAccessKey: "SK", Region: "us-west-2", Service: Service}
+	s4.SignV4(signed, body, amzDate)
+	if r.Method != "POST" || signed.Header.Get("Authorization") != r.Header.Get("Authorization") {
+		w.WriteHeader(401)
+		w.Write([]byte(`{"__type":"com.amazon.paapi5#InvalidSignatureException","Errors":[{"Code":"InvalidSignature","Message":"The request has not been correctly signed."}]}`))
+		return
+	}
+	target := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), targetPrefix)
+	payload := map[string]interface{}{}
+	json.Unmarshal(body, &payload)
+	s.mu.Lock()
+	s.payloads = append(s.payloads, payload)
+	s.targets = append(s.targets, target+" "+r.URL.Path)
+	reply, ok := s.replies[target]
+	s.mu.Unlock()
+	if !ok {
+		w.WriteHeader(404)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(reply.status)
+	w.Write([]byte(reply.body))
+}
+
+func (s *standInServer) newClient(t *testing.T) *Client {
+	client, err := New("AK", "SK", "ngsio-22", amazon.RegionJapan, WithEndpoint(s.URL))
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	return client
+}
+
+func TestNew(t *testing.T) {
+	client, err := New("AK", "SK", "ngsio-22", amazon.RegionJapan)
+	Test{nil, err}.Compare(t)
+	Test{"https://webservices.amazon.co.jp", client.Endpoint()}.Compare(t)
+	Test{"www.amazon.co.jp", client.Marketplace()}.Compare(t)
+	Test{"us-west-2", AWSRegion(client.Region)}.Compare(t)
+	for _, test := range []struct {
+		accessKeyID, secretAccessKey, partnerTag string
+		region                                   amazon.Region
+		expected                                 string
+	}{
+		{"", "SK", "ngsio-22", amazon.RegionJapan, "AccessKeyID is not specified"},
+		{"AK", "", "ngsio-22", amazon.RegionJapan, "SecretAccessKey is not specified"},
+		{"AK", "SK", "", amazon.RegionJapan, "PartnerTag is not specified"},
+		{"AK", "SK", "ngsio-22", "", "Region is not specified"},
+		{"AK", "SK", "ngsio-22", amazon.RegionChina, "Invalid Region CN"},
+	} {
+		client, err := New(test.accessKeyID, test.secretAccessKey, test.partnerTag, test.region)
+		Test{test.expected, err.Error()}.Compare(t)
+		if client != nil {
+			t.Errorf(`Expected nil but got "%v"`, client)
+		}
+	}
+}
+
+func TestWithEndpoint(t *testing.T) {
+	client, _ := New("AK", "SK", "ngsio-22", amazon.RegionUS, WithEndpoint("http://localhost:8080/"))
+	Test{"http://localhost:8080", client.Endpoint()}.Compare(t)
+	_, err := New("AK", "SK", "ngsio-22", amazon.RegionUS, WithEndpoint("localhost"))
+	Test{"Invalid Endpoint localhost: scheme must be http or https", err.Error()}.Compare(t)
+	_, err = New("AK", "SK", "ngsio-22", amazon.RegionUS, WithHTTPClient(nil))
+	Test{"HTTPClient is not specified", err.Error()}.Compare(t)
+}
+
+func TestNewRequestSigned(t *testing.T) {
+	client, _ := New("AK", "SK", "ngsio-22", amazon.RegionJapan)
+	setNow(time.Date(2019, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	req, err := client.newRequest(context.Background(), client.GetItems(GetItemsParameters{ItemIDs: []string{"4621300253"}}))
+	Test{nil, err}.Compare(t)
+	Test{"https://webservices.amazon.co.jp/paapi5/getitems", req.URL.String()}.Compare(t)
+	Test{"amz-1.0", req.Header.Get("Content-Encoding")}.Compare(t)
+	Test{"com.amazon.paapi5.v1.ProductAdvertisingAPIv1.GetItems", req.Header.Get("X-Amz-Target")}.Compare(t)
+	Test{"20191116T123400Z", req.Header.Get("X-Amz-Date")}.Compare(t)
+	Test{true, strings.HasPrefix(req.Header.Get("Authorization"),
+		"AWS4-HMAC-SHA256 Credential=AK/20191116/us-west-2/ProductAdvertisingAPI/aws4_request, SignedHeaders=content-encoding;content-type;host;x-amz-date;x-amz-target, Signature=")}.Compare(t)
+}
+
+func TestNewWithCredentials(t *testing.T) {
+	client, err := NewWithCredentials(credentials.NewStatic("AK", "SK", "TOKEN"), "ngsio-22", amazon.RegionJapan)
+	Test{nil, err}.Compare(t)
+	Test{"", client.AccessKeyID}.Compare(t)
+	setNow(time.Date(2019, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
+	req, err := client.newRequest(context.Background(), client.GetItems(GetItemsParameters{ItemIDs: []string{"4621300253"}}))
+	Test{nil, err}.Compare(t)
+	Test{"TOKEN", req.Header.Get("X-Amz-Security-Token")}.Compare(t)
+	Test{true, strings.HasPrefix(req.Header.Get("Authorization"),
+		"AWS4-HMAC-SHA256 Credential=AK/20191116/us-west-2/ProductAdvertisingAPI/aws4_request, SignedHeaders=content-encoding;content-type;host;x-amz-date;x-amz-security-token;x-amz-target, Signature=")}.Compare(t)
+
+	_, err = NewWithCredentials(nil, "ngsio-22", amazon.RegionJapan)
+	Test{"Credentials is not specified", err.Error()}.Compare(t)
+	_, err = NewWithCredentials(credentials.NewStatic("AK", "SK", ""), "ngsio-22", amazon.RegionChina)
+	Test{"Invalid Region CN", err.Error()}.Compare(t)
+
+	client, _ = NewWithCredentials(credentials.NewStatic("", "", ""), "ngsio-22", amazon.RegionJapan)
+	_, err = client.GetItems(GetItemsParameters{ItemIDs: []string{"4621300253"}}).Do()
+	Test{"AccessKeyID is not specified", err.Error()}.Compare(t)
+}
+
+func TestNewFromEnvironment(t *testing.T) {
+	os.Setenv("AWS_ACCESS_KEY_ID", "AK")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "SK")
+	os.Setenv("AWS_PRODUCT_REGION", "JP")
+	os.Setenv("AWS_ASSOCIATE_TAG", "ngsio-22")
+	defer os.Setenv("AWS_ACCESS_KEY_ID", "")
+	defer os.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	client, err := NewFromEnvironment()
+	Test{nil, err}.Compare(t)
+	for _, test := range []Test{
+		{"AK", client.AccessKeyID},
+		{"SK", client.SecretAccessKey},
+		{"ngsio-22", client.PartnerTag},
+		{amazon.RegionJapan, client.Region},
+	} {
+		test.Compare(t)
+	}
+
+	os.Setenv("AWS_ACCESS_KEY_ID", "")
+	_, err = NewFromEnvironment()
+	Test{"AccessKeyID is not specified", err.Error()}.Compare(t)
+}
+
+func TestNewFromDefaultChain(t *testing.T) {
+	os.Setenv("AWS_ACCESS_KEY_ID", "AK")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "SK")
+	os.Setenv("AWS_PRODUCT_REGION", "JP")
+	os.Setenv("AWS_ASSOCIATE_TAG", "ngsio-22")
+	defer os.Setenv("AWS_ACCESS_KEY_ID", "")
+	defer os.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	client, err := NewFromDefaultChain()
+	Test{nil, err}.Compare(t)
+	Test{"", client.AccessKeyID}.Compare(t)
+	Test{"ngsio-22", client.PartnerTag}.Compare(t)
+	Test{amazon.RegionJapan, client.Region}.Compare(t)
+	creds, err := client.retrieveCredentials(context.Background())
+	Test{nil, err}.Compare(t)
+	Test{"AK", creds.AccessKeyID}.Compare(t)
+}
+
+func TestDoRequestInvalidSignature(t *testing.T) {
+	server := newStandInServer(nil)
+	defer server.Close()
+	client, _ := New("AK", "wrong", "ngsio-22", amazon.RegionJapan, WithEndpoint(server.URL))
+	_, err := client.GetItems(GetItemsParameters{ItemIDs: []string{"4621300253"}}).Do()
+	e, ok := err.(*ErrorResponse)
+	Test{true, ok}.Compare(t)
+	Test{401, e.StatusCode}.Compare(t)
+	Test{InvalidSignature, e.Code()}.Compare(t)
+	Test{"HTTP 401 Unauthorized: Error InvalidSignature: The request has not been correctly signed.", err.Error()}.Compare(t)
+}
+
+func TestDoRequestHTTPError(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"GetItems": {503, "<html>Service Unavailable</html>"}})
+	defer server.Close()
+	_, err := server.newClient(t).GetItems(GetItemsParameters{ItemIDs: []string{"4621300253"}}).Do()
+	e, ok := err.(*amazon.HTTPError)
+	Test{true, ok}.Compare(t)
+	Test{503, e.StatusCode}.Compare(t)
+	Test{"<html>Service Unavailable</html>", string(e.Body)}.Compare(t)
+}
@@ -0,0 +1,77 @@
+package paapi5
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+const (
+	// AccessDenied AccessDenied
+	AccessDenied amazon.ErrorCode = "AccessDenied"
+	// AccessDeniedAwsUsers AccessDeniedAwsUsers
+	AccessDeniedAwsUsers amazon.ErrorCode = "AccessDeniedAwsUsers"
+	// IncompleteSignature IncompleteSignature
+	IncompleteSignature amazon.ErrorCode = "IncompleteSignature"
+	// InvalidAssociate InvalidAssociate
+	InvalidAssociate amazon.ErrorCode = "InvalidAssociate"
+	// InvalidParameterValue InvalidParameterValue
+	InvalidParameterValue amazon.ErrorCode = "InvalidParameterValue"
+	// InvalidPartnerTag InvalidPartnerTag
+	InvalidPartnerTag amazon.ErrorCode = "InvalidPartnerTag"
+	// InvalidSignature InvalidSignature
+	InvalidSignature amazon.ErrorCode = "InvalidSignature"
+	// ItemNotAccessible ItemNotAccessible
+	ItemNotAccessible amazon.ErrorCode = "ItemNotAccessible"
+	// MissingParameter MissingParameter
+	MissingParameter amazon.ErrorCode = "MissingParameter"
+	// NoResults NoResults
+	NoResults amazon.ErrorCode = "NoResults"
+	// TooManyRequests TooManyRequests
+	TooManyRequests amazon.ErrorCode = "TooManyRequests"
+	// UnrecognizedClient UnrecognizedClient
+	UnrecognizedClient amazon.ErrorCode = "UnrecognizedClient"
+)
+
+// maxHTTPErrorBodySize is maximum length of Body kept in amazon.HTTPError
+const maxHTTPErrorBodySize = 1024
+
+// ErrorResponse represents non-2xx response with errors of the API
+type ErrorResponse struct {
+	StatusCode int    `json:"-"`
+	Type       string `json:"__type"`
+	Errors     []amazon.Error
+}
+
+func newErrorResponse(res *http.Response, data []byte) *ErrorResponse {
+	e := ErrorResponse{}
+	if err := json.Unmarshal(data, &e); err != nil || len(e.Errors) == 0 {
+		return nil
+	}
+	e.StatusCode = res.StatusCode
+	return &e
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("HTTP %v %v: %v", e.StatusCode, http.StatusText(e.StatusCode), errorsOf(e.Errors))
+}
+
+// Code returns code of the first error
+func (e *ErrorResponse) Code() amazon.ErrorCode {
+	return e.Errors[0].Code
+}
+
+// Unwrap returns the errors so that errors.Is matches error codes such as InvalidParameterValue
+func (e *ErrorResponse) Unwrap() error {
+	return errorsOf(e.Errors)
+}
+
+// errorsOf returns errors as *amazon.Errors, or nil if empty
+func errorsOf(errs []amazon.Error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &amazon.Errors{ErrorNode: errs}
+}
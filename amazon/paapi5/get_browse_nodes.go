@@ -0,0 +1,90 @@
+package paapi5
+
+import (
+	"context"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+// GetBrowseNodesParameters represents parameters for GetBrowseNodes operation request
+type GetBrowseNodesParameters struct {
+	BrowseNodeIDs         []string
+	LanguagesOfPreference []string
+	Resources             []Resource
+}
+
+// GetBrowseNodesRequest represents request for GetBrowseNodes operation
+type GetBrowseNodesRequest struct {
+	Client     *Client
+	Parameters GetBrowseNodesParameters
+}
+
+// GetBrowseNodesResponse represents response for GetBrowseNodes operation
+type GetBrowseNodesResponse struct {
+	BrowseNodesResult struct {
+		BrowseNodes []BrowseNode
+	}
+	Errors []amazon.Error
+}
+
+// Error returns errors of browse nodes not found
+func (res *GetBrowseNodesResponse) Error() error {
+	return errorsOf(res.Errors)
+}
+
+// BrowseNodes returns found browse nodes
+func (res *GetBrowseNodesResponse) BrowseNodes() []BrowseNode {
+	return res.BrowseNodesResult.BrowseNodes
+}
+
+type getBrowseNodesPayload struct {
+	partner
+	BrowseNodeIds         []string
+	LanguagesOfPreference []string   `json:",omitempty"`
+	Resources             []Resource `json:",omitempty"`
+}
+
+func (req *GetBrowseNodesRequest) payload() interface{} {
+	return getBrowseNodesPayload{
+		partner:               req.Client.partner(),
+		BrowseNodeIds:         req.Parameters.BrowseNodeIDs,
+		LanguagesOfPreference: req.Parameters.LanguagesOfPreference,
+		Resources:             req.Parameters.Resources,
+	}
+}
+
+func (req *GetBrowseNodesRequest) operation() string {
+	return "GetBrowseNodes"
+}
+
+func (req *GetBrowseNodesRequest) path() string {
+	return "/paapi5/getbrowsenodes"
+}
+
+// Do sends request for the API
+func (req *GetBrowseNodesRequest) Do() (*GetBrowseNodesResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context.
+// Errors of some browse nodes are kept in Errors of the response as long as any browse node is found
+func (req *GetBrowseNodesRequest) DoContext(ctx context.Context) (*GetBrowseNodesResponse, error) {
+	respObj := GetBrowseNodesResponse{}
+	if _, err := req.Client.doRequest(ctx, req, &respObj); err != nil {
+		return nil, err
+	}
+	if len(respObj.BrowseNodesResult.BrowseNodes) == 0 {
+		if err := respObj.Error(); err != nil {
+			return nil, err
+		}
+	}
+	return &respObj, nil
+}
+
+// GetBrowseNodes returns new request for GetBrowseNodes
+func (client *Client) GetBrowseNodes(parameters GetBrowseNodesParameters) *GetBrowseNodesRequest {
+	return &GetBrowseNodesRequest{
+		Client:     client,
+		Parameters: parameters,
+	}
+}
@@ -0,0 +1,35 @@
+package paapi5
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetBrowseNodes(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"GetBrowseNodes": {200, `{
+  "BrowseNodesResult": {
+    "BrowseNodes": [{
+      "Id": "466298",
+      "DisplayName": "コンピュータ・IT",
+      "ContextFreeName": "コンピュータ・IT",
+      "IsRoot": false,
+      "Ancestor": {"Id": "465610", "DisplayName": "ジャンル別", "Ancestor": {"Id": "465392", "DisplayName": "本"}},
+      "Children": [{"Id": "492352", "DisplayName": "プログラミング"}]
+    }]
+  },
+  "Errors": [{"Code": "InvalidParameterValue", "Message": "The BrowseNodeId 0 provided in the request is invalid."}]
+}`}})
+	defer server.Close()
+	res, err := server.newClient(t).GetBrowseNodes(GetBrowseNodesParameters{
+		BrowseNodeIDs: []string{"466298", "0"},
+		Resources:     []Resource{ResourceBrowseNodesAncestor, ResourceBrowseNodesChildren},
+	}).Do()
+	Test{nil, err}.Compare(t)
+	Test{"GetBrowseNodes /paapi5/getbrowsenodes", server.targets[0]}.Compare(t)
+	Test{[]interface{}{"466298", "0"}, server.payloads[0]["BrowseNodeIds"]}.DeepEqual(t)
+	node := res.BrowseNodes()[0]
+	Test{"466298", node.ID}.Compare(t)
+	Test{"本", node.Ancestor.Ancestor.DisplayName}.Compare(t)
+	Test{"492352", node.Children[0].ID}.Compare(t)
+	Test{true, errors.Is(res.Error(), InvalidParameterValue)}.Compare(t)
+}
@@ -0,0 +1,125 @@
+package paapi5
+
+import (
+	"context"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+// ItemIDType represents ItemIdType parameter
+type ItemIDType string
+
+const (
+	// ItemIDTypeASIN is a constant for ASIN item ID type, the only type of the API
+	ItemIDTypeASIN ItemIDType = "ASIN"
+)
+
+// MaxGetItemsItemIDs is maximum number of ItemIds in a GetItems request
+const MaxGetItemsItemIDs = 10
+
+// GetItemsParameters represents parameters for GetItems operation request
+type GetItemsParameters struct {
+	ItemIDs               []string
+	ItemIDType            ItemIDType
+	Resources             []Resource
+	Condition             amazon.Condition
+	CurrencyOfPreference  string
+	LanguagesOfPreference []string
+	Merchant              string
+	OfferCount            int
+}
+
+// GetItemsRequest represents request for GetItems operation
+type GetItemsRequest struct {
+	Client     *Client
+	Parameters GetItemsParameters
+}
+
+// GetItemsResponse represents response for GetItems operation
+type GetItemsResponse struct {
+	ItemsResult struct {
+		Items []Item
+	}
+	Errors []amazon.Error
+}
+
+// Error returns errors of items not found or not accessible
+func (res *GetItemsResponse) Error() error {
+	return errorsOf(res.Errors)
+}
+
+// Items returns found items
+func (res *GetItemsResponse) Items() []Item {
+	return res.ItemsResult.Items
+}
+
+type getItemsPayload struct {
+	partner
+	ItemIds               []string
+	ItemIDType            ItemIDType `json:"ItemIdType,omitempty"`
+	Resources             []Resource `json:",omitempty"`
+	Condition             string     `json:",omitempty"`
+	CurrencyOfPreference  string     `json:",omitempty"`
+	LanguagesOfPreference []string   `json:",omitempty"`
+	Merchant              string     `json:",omitempty"`
+	OfferCount            int        `json:",omitempty"`
+}
+
+func (req *GetItemsRequest) payload() interface{} {
+	return getItemsPayload{
+		partner:               req.Client.partner(),
+		ItemIds:               req.Parameters.ItemIDs,
+		ItemIDType:            req.Parameters.ItemIDType,
+		Resources:             req.Parameters.Resources,
+		Condition:             condition(req.Parameters.Condition),
+		CurrencyOfPreference:  req.Parameters.CurrencyOfPreference,
+		LanguagesOfPreference: req.Parameters.LanguagesOfPreference,
+		Merchant:              req.Parameters.Merchant,
+		OfferCount:            req.Parameters.OfferCount,
+	}
+}
+
+func (req *GetItemsRequest) operation() string {
+	return "GetItems"
+}
+
+func (req *GetItemsRequest) path() string {
+	return "/paapi5/getitems"
+}
+
+// Do sends request for the API
+func (req *GetItemsRequest) Do() (*GetItemsResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context.
+// Errors of some items are kept in Errors of the response as long as any item is found
+func (req *GetItemsRequest) DoContext(ctx context.Context) (*GetItemsResponse, error) {
+	respObj := GetItemsResponse{}
+	if _, err := req.Client.doRequest(ctx, req, &respObj); err != nil {
+		return nil, err
+	}
+	(&amazon.Errors{ErrorNode: respObj.Errors}).ResolveItemIDs(req.Parameters.ItemIDs)
+	if len(respObj.ItemsResult.Items) == 0 {
+		if err := respObj.Error(); err != nil {
+			return nil, err
+		}
+	}
+	return &respObj, nil
+}
+
+// GetItems returns new request for GetItems
+func (client *Client) GetItems(parameters GetItemsParameters) *GetItemsRequest {
+	return &GetItemsRequest{
+		Client:     client,
+		Parameters: parameters,
+	}
+}
+
+// condition returns Condition parameter of PA-API 5.0, in which ConditionAll is Any
+func condition(c amazon.Condition) string {
+	if c == amazon.ConditionAll {
+		return "Any"
+	}
+	return string(c)
+}
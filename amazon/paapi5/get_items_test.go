@@ -0,0 +1,89 @@
+package paapi5
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+const getItemsResponse = `{
+  "Errors": [{
+    "__type": "com.amazon.paapi5#ErrorData",
+    "Code": "InvalidParameterValue",
+    "Message": "The ItemId B00000INVALID provided in the request is invalid."
+  }],
+  "ItemsResult": {
+    "Items": [{
+      "ASIN": "4621300253",
+      "DetailPageURL": "https://www.amazon.co.jp/dp/4621300253?tag=ngsio-22",
+      "ItemInfo": {
+        "ByLineInfo": {
+          "Contributors": [{"Locale": "ja_JP", "Name": "Alan A.A. Donovan", "Role": "著", "RoleType": "author"}],
+          "Manufacturer": {"DisplayValue": "丸善出版", "Label": "Manufacturer", "Locale": "ja_JP"}
+        },
+        "ExternalIds": {"ISBNs": {"DisplayValues": ["4621300253"], "Label": "ISBN", "Locale": "ja_JP"}},
+        "Title": {"DisplayValue": "プログラミング言語Go", "Label": "Title", "Locale": "ja_JP"}
+      },
+      "Offers": {
+        "Listings": [{
+          "Id": "listing-id",
+          "Condition": {"Value": "New"},
+          "DeliveryInfo": {"IsPrimeEligible": true},
+          "IsBuyBoxWinner": true,
+          "Price": {"Amount": 3996, "Currency": "JPY", "DisplayAmount": "￥3,996"}
+        }]
+      }
+    }]
+  }
+}`
+
+func TestGetItems(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"GetItems": {200, getItemsResponse}})
+	defer server.Close()
+	res, err := server.newClient(t).GetItems(GetItemsParameters{
+		ItemIDs:   []string{"4621300253", "B00000INVALID"},
+		Resources: []Resource{ResourceItemInfoTitle, ResourceOffersListingsPrice},
+		Condition: amazon.ConditionAll,
+	}).Do()
+	Test{nil, err}.Compare(t)
+	Test{"GetItems /paapi5/getitems", server.targets[0]}.Compare(t)
+	Test{map[string]interface{}{
+		"PartnerTag":  "ngsio-22",
+		"PartnerType": "Associates",
+		"Marketplace": "www.amazon.co.jp",
+		"ItemIds":     []interface{}{"4621300253", "B00000INVALID"},
+		"Resources":   []interface{}{"ItemInfo.Title", "Offers.Listings.Price"},
+		"Condition":   "Any",
+	}, server.payloads[0]}.DeepEqual(t)
+	Test{1, len(res.Items())}.Compare(t)
+	item := res.Items()[0]
+	Test{"4621300253", item.ASIN}.Compare(t)
+	Test{"プログラミング言語Go", item.ItemInfo.Title.DisplayValue}.Compare(t)
+	Test{"Alan A.A. Donovan", item.ItemInfo.ByLineInfo.Contributors[0].Name}.Compare(t)
+	Test{[]string{"4621300253"}, item.ItemInfo.ExternalIds.ISBNs.DisplayValues}.DeepEqual(t)
+	Test{3996.0, item.Offers.Listings[0].Price.Amount}.Compare(t)
+	Test{true, item.Offers.Listings[0].DeliveryInfo.IsPrimeEligible}.Compare(t)
+	Test{"B00000INVALID", res.Errors[0].ItemID}.Compare(t)
+	Test{true, errors.Is(res.Error(), InvalidParameterValue)}.Compare(t)
+}
+
+func TestGetItemsNotFound(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"GetItems": {200, `{"Errors":[{"Code":"ItemNotAccessible","Message":"The ItemId B00000000X is not accessible through the Product Advertising API."}]}`}})
+	defer server.Close()
+	res, err := server.newClient(t).GetItems(GetItemsParameters{ItemIDs: []string{"B00000000X"}}).Do()
+	if res != nil {
+		t.Errorf(`Expected nil but got "%v"`, res)
+	}
+	Test{true, errors.Is(err, ItemNotAccessible)}.Compare(t)
+	Test{true, errors.Is(err, amazon.Error{Code: ItemNotAccessible, ItemID: "B00000000X"})}.Compare(t)
+}
+
+func TestGetItemsErrorResponse(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"GetItems": {400, `{"__type":"com.amazon.paapi5#InvalidParameterValueException","Errors":[{"Code":"InvalidParameterValue","Message":"The value provided in the request for ItemIds is invalid."}]}`}})
+	defer server.Close()
+	_, err := server.newClient(t).GetItems(GetItemsParameters{}).Do()
+	Test{true, errors.Is(err, InvalidParameterValue)}.Compare(t)
+	Test{"HTTP 400 Bad Request: Error InvalidParameterValue: The value provided in the request for ItemIds is invalid.", err.Error()}.Compare(t)
+	Test{"com.amazon.paapi5#InvalidParameterValueException", err.(*ErrorResponse).Type}.Compare(t)
+}
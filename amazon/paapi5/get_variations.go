@@ -0,0 +1,129 @@
+package paapi5
+
+import (
+	"context"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+// GetVariationsParameters represents parameters for GetVariations operation request
+type GetVariationsParameters struct {
+	ASIN                  string
+	Condition             amazon.Condition
+	CurrencyOfPreference  string
+	LanguagesOfPreference []string
+	Merchant              string
+	OfferCount            int
+	VariationCount        int
+	VariationPage         int
+	Resources             []Resource
+}
+
+// GetVariationsRequest represents request for GetVariations operation
+type GetVariationsRequest struct {
+	Client     *Client
+	Parameters GetVariationsParameters
+}
+
+// GetVariationsResponse represents response for GetVariations operation
+type GetVariationsResponse struct {
+	VariationsResult struct {
+		Items            []Item
+		VariationSummary VariationSummary
+	}
+	Errors []amazon.Error
+}
+
+// VariationSummary represents summary of variations
+type VariationSummary struct {
+	PageCount           int
+	VariationCount      int
+	Price               *VariationPrice
+	VariationDimensions []VariationDimension
+}
+
+// VariationPrice represents price range of variations
+type VariationPrice struct {
+	HighestPrice *OfferPrice
+	LowestPrice  *OfferPrice
+}
+
+// VariationDimension represents dimension of variations such as size and color
+type VariationDimension struct {
+	DisplayName string
+	Locale      string
+	Name        string
+	Values      []string
+}
+
+// Error returns errors of the response
+func (res *GetVariationsResponse) Error() error {
+	return errorsOf(res.Errors)
+}
+
+// Items returns variation items
+func (res *GetVariationsResponse) Items() []Item {
+	return res.VariationsResult.Items
+}
+
+type getVariationsPayload struct {
+	partner
+	ASIN                  string
+	Condition             string     `json:",omitempty"`
+	CurrencyOfPreference  string     `json:",omitempty"`
+	LanguagesOfPreference []string   `json:",omitempty"`
+	Merchant              string     `json:",omitempty"`
+	OfferCount            int        `json:",omitempty"`
+	VariationCount        int        `json:",omitempty"`
+	VariationPage         int        `json:",omitempty"`
+	Resources             []Resource `json:",omitempty"`
+}
+
+func (req *GetVariationsRequest) payload() interface{} {
+	p := req.Parameters
+	return getVariationsPayload{
+		partner:               req.Client.partner(),
+		ASIN:                  p.ASIN,
+		Condition:             condition(p.Condition),
+		CurrencyOfPreference:  p.CurrencyOfPreference,
+		LanguagesOfPreference: p.LanguagesOfPreference,
+		Merchant:              p.Merchant,
+		OfferCount:            p.OfferCount,
+		VariationCount:        p.VariationCount,
+		VariationPage:         p.VariationPage,
+		Resources:             p.Resources,
+	}
+}
+
+func (req *GetVariationsRequest) operation() string {
+	return "GetVariations"
+}
+
+func (req *GetVariationsRequest) path() string {
+	return "/paapi5/getvariations"
+}
+
+// Do sends request for the API
+func (req *GetVariationsRequest) Do() (*GetVariationsResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *GetVariationsRequest) DoContext(ctx context.Context) (*GetVariationsResponse, error) {
+	respObj := GetVariationsResponse{}
+	if _, err := req.Client.doRequest(ctx, req, &respObj); err != nil {
+		return nil, err
+	}
+	if err := respObj.Error(); err != nil {
+		return nil, err
+	}
+	return &respObj, nil
+}
+
+// GetVariations returns new request for GetVariations
+func (client *Client) GetVariations(parameters GetVariationsParameters) *GetVariationsRequest {
+	return &GetVariationsRequest{
+		Client:     client,
+		Parameters: parameters,
+	}
+}
@@ -0,0 +1,38 @@
+package paapi5
+
+import (
+	"testing"
+)
+
+func TestGetVariations(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"GetVariations": {200, `{
+  "VariationsResult": {
+    "Items": [
+      {"ASIN": "B07X1", "ParentASIN": "B07X0", "VariationAttributes": [{"Name": "size_name", "Value": "S"}]},
+      {"ASIN": "B07X2", "ParentASIN": "B07X0", "VariationAttributes": [{"Name": "size_name", "Value": "M"}]}
+    ],
+    "VariationSummary": {
+      "PageCount": 1,
+      "VariationCount": 2,
+      "Price": {
+        "HighestPrice": {"Amount": 2980, "Currency": "JPY", "DisplayAmount": "￥2,980"},
+        "LowestPrice": {"Amount": 1980, "Currency": "JPY", "DisplayAmount": "￥1,980"}
+      },
+      "VariationDimensions": [{"DisplayName": "サイズ", "Locale": "ja_JP", "Name": "size_name", "Values": ["S", "M"]}]
+    }
+  }
+}`}})
+	defer server.Close()
+	res, err := server.newClient(t).GetVariations(GetVariationsParameters{ASIN: "B07X0", VariationPage: 1}).Do()
+	Test{nil, err}.Compare(t)
+	Test{"GetVariations /paapi5/getvariations", server.targets[0]}.Compare(t)
+	Test{"B07X0", server.payloads[0]["ASIN"]}.Compare(t)
+	Test{1.0, server.payloads[0]["VariationPage"]}.Compare(t)
+	Test{2, len(res.Items())}.Compare(t)
+	Test{"B07X0", res.Items()[0].ParentASIN}.Compare(t)
+	Test{"M", res.Items()[1].VariationAttributes[0].Value}.Compare(t)
+	summary := res.VariationsResult.VariationSummary
+	Test{2, summary.VariationCount}.Compare(t)
+	Test{1980.0, summary.Price.LowestPrice.Amount}.Compare(t)
+	Test{[]string{"S", "M"}, summary.VariationDimensions[0].Values}.DeepEqual(t)
+}
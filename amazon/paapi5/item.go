@@ -0,0 +1,231 @@
+package paapi5
+
+// Item represents Item of the response
+type Item struct {
+	ASIN                string
+	DetailPageURL       string
+	ParentASIN          string
+	Score               float64
+	ItemInfo            ItemInfo
+	Images              Images
+	Offers              Offers
+	BrowseNodeInfo      BrowseNodeInfo
+	VariationAttributes []VariationAttribute
+}
+
+// ItemInfo represents ItemInfo
+type ItemInfo struct {
+	Title           *SingleStringValuedAttribute
+	ByLineInfo      *ByLineInfo
+	Classifications *Classifications
+	ContentInfo     *ContentInfo
+	ExternalIds     *ExternalIds
+	Features        *MultiValuedAttribute
+	ProductInfo     *ProductInfo
+}
+
+// SingleStringValuedAttribute represents attribute with a string value
+type SingleStringValuedAttribute struct {
+	DisplayValue string
+	Label        string
+	Locale       string
+}
+
+// SingleIntegerValuedAttribute represents attribute with an integer value
+type SingleIntegerValuedAttribute struct {
+	DisplayValue int
+	Label        string
+	Locale       string
+}
+
+// SingleBooleanValuedAttribute represents attribute with a boolean value
+type SingleBooleanValuedAttribute struct {
+	DisplayValue bool
+	Label        string
+	Locale       string
+}
+
+// MultiValuedAttribute represents attribute with string values
+type MultiValuedAttribute struct {
+	DisplayValues []string
+	Label         string
+	Locale        string
+}
+
+// ByLineInfo represents ByLineInfo
+type ByLineInfo struct {
+	Brand        *SingleStringValuedAttribute
+	Manufacturer *SingleStringValuedAttribute
+	Contributors []Contributor
+}
+
+// Contributor represents Contributor such as author
+type Contributor struct {
+	Locale   string
+	Name     string
+	Role     string
+	RoleType string
+}
+
+// Classifications represents Classifications
+type Classifications struct {
+	Binding      *SingleStringValuedAttribute
+	ProductGroup *SingleStringValuedAttribute
+}
+
+// ContentInfo represents ContentInfo
+type ContentInfo struct {
+	Edition         *SingleStringValuedAttribute
+	Languages       *Languages
+	PagesCount      *SingleIntegerValuedAttribute
+	PublicationDate *SingleStringValuedAttribute
+}
+
+// Languages represents Languages
+type Languages struct {
+	DisplayValues []LanguageType
+	Label         string
+	Locale        string
+}
+
+// LanguageType represents language and its type such as Published
+type LanguageType struct {
+	DisplayValue string
+	Type         string
+}
+
+// ExternalIds represents ExternalIds
+type ExternalIds struct {
+	EANs  *MultiValuedAttribute
+	ISBNs *MultiValuedAttribute
+	UPCs  *MultiValuedAttribute
+}
+
+// ProductInfo represents ProductInfo
+type ProductInfo struct {
+	Color          *SingleStringValuedAttribute
+	IsAdultProduct *SingleBooleanValuedAttribute
+	ReleaseDate    *SingleStringValuedAttribute
+	Size           *SingleStringValuedAttribute
+	UnitCount      *SingleIntegerValuedAttribute
+}
+
+// Images represents Images
+type Images struct {
+	Primary  *ImageType
+	Variants []ImageType
+}
+
+// ImageType represents set of images in sizes
+type ImageType struct {
+	Small  *ImageSize
+	Medium *ImageSize
+	Large  *ImageSize
+}
+
+// ImageSize represents image
+type ImageSize struct {
+	URL    string
+	Height int
+	Width  int
+}
+
+// Offers represents Offers
+type Offers struct {
+	Listings  []OfferListing
+	Summaries []OfferSummary
+}
+
+// OfferListing represents offer listing
+type OfferListing struct {
+	ID             string `json:"Id"`
+	Availability   *OfferAvailability
+	Condition      *OfferCondition
+	DeliveryInfo   *OfferDeliveryInfo
+	IsBuyBoxWinner bool
+	MerchantInfo   *OfferMerchantInfo
+	Price          *OfferPrice
+}
+
+// OfferAvailability represents availability of offer
+type OfferAvailability struct {
+	MaxOrderQuantity int
+	Message          string
+	MinOrderQuantity int
+	Type             string
+}
+
+// OfferCondition represents condition of offer
+type OfferCondition struct {
+	DisplayValue string
+	Label        string
+	Locale       string
+	Value        string
+}
+
+// OfferDeliveryInfo represents delivery info of offer
+type OfferDeliveryInfo struct {
+	IsAmazonFulfilled      bool
+	IsFreeShippingEligible bool
+	IsPrimeEligible        bool
+}
+
+// OfferMerchantInfo represents merchant of offer
+type OfferMerchantInfo struct {
+	ID   string `json:"Id"`
+	Name string
+}
+
+// OfferPrice represents price of offer
+type OfferPrice struct {
+	Amount        float64
+	Currency      string
+	DisplayAmount string
+	Savings       *OfferSavings
+}
+
+// OfferSavings represents savings of offer
+type OfferSavings struct {
+	Amount        float64
+	Currency      string
+	DisplayAmount string
+	Percentage    int
+}
+
+// OfferSummary represents summary of offers by condition
+type OfferSummary struct {
+	Condition    *OfferCondition
+	HighestPrice *OfferPrice
+	LowestPrice  *OfferPrice
+	OfferCount   int
+}
+
+// BrowseNodeInfo represents BrowseNodeInfo
+type BrowseNodeInfo struct {
+	BrowseNodes      []BrowseNode
+	WebsiteSalesRank *WebsiteSalesRank
+}
+
+// BrowseNode represents BrowseNode
+type BrowseNode struct {
+	ID              string `json:"Id"`
+	DisplayName     string
+	ContextFreeName string
+	IsRoot          bool
+	SalesRank       int
+	Ancestor        *BrowseNode
+	Children        []BrowseNode
+}
+
+// WebsiteSalesRank represents sales rank in the website
+type WebsiteSalesRank struct {
+	ContextFreeName string
+	DisplayName     string
+	SalesRank       int
+}
+
+// VariationAttribute represents variation dimension and value of the item
+type VariationAttribute struct {
+	Name  string
+	Value string
+}
@@ -0,0 +1,61 @@
+package paapi5
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+// Option configures Client on New
+type Option func(*Client) error
+
+// WithHTTPClient sets HTTPDoer that sends HTTP requests
+func WithHTTPClient(doer amazon.HTTPDoer) Option {
+	return func(client *Client) error {
+		if doer == nil {
+			return errors.New("HTTPClient is not specified")
+		}
+		client.HTTPClient = doer
+		return nil
+	}
+}
+
+// WithTransport sets http.RoundTripper used by the HTTP client
+func WithTransport(transport http.RoundTripper) Option {
+	return func(client *Client) error {
+		if transport == nil {
+			return errors.New("Transport is not specified")
+		}
+		client.HTTPClient = &http.Client{Transport: transport}
+		return nil
+	}
+}
+
+// WithEndpoint overrides base URL of the API such as http://localhost:8080.
+// Requests are sent to and signed with the host of the URL, and paths of the operations are appended to it
+func WithEndpoint(endpoint string) Option {
+	return func(client *Client) error {
+		if endpoint == "" {
+			return errors.New("Endpoint is not specified")
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return fmt.Errorf("Invalid Endpoint %v: %v", endpoint, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("Invalid Endpoint %v: scheme must be http or https", endpoint)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("Invalid Endpoint %v: host is not specified", endpoint)
+		}
+		if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+			return fmt.Errorf("Invalid Endpoint %v: userinfo, query and fragment are not allowed", endpoint)
+		}
+		client.EndpointURL = strings.TrimSuffix(u.String(), "/")
+		return nil
+	}
+}
@@ -0,0 +1,26 @@
+package paapi5
+
+import "github.com/ngs/go-amazon-product-advertising-api/amazon"
+
+// Host returns host of PA-API 5.0 for the region
+func Host(region amazon.Region) string {
+	return region.PAAPI5Host()
+}
+
+// AWSRegion returns AWS region used to sign requests for the region
+func AWSRegion(region amazon.Region) string {
+	return region.PAAPI5Region()
+}
+
+// Marketplace returns marketplace for the region such as www.amazon.co.jp
+func Marketplace(region amazon.Region) string {
+	if !IsSupported(region) {
+		return ""
+	}
+	return region.Domain()
+}
+
+// IsSupported returns the region is served by PA-API 5.0. China is not served
+func IsSupported(region amazon.Region) bool {
+	return region.PAAPI5Host() != ""
+}
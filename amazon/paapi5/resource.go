@@ -0,0 +1,73 @@
+package paapi5
+
+// Resource represents Resources parameter specifying data returned in the response
+type Resource string
+
+const (
+	// ResourceBrowseNodeInfoBrowseNodes is a constant for BrowseNodeInfo.BrowseNodes resource
+	ResourceBrowseNodeInfoBrowseNodes Resource = "BrowseNodeInfo.BrowseNodes"
+	// ResourceBrowseNodeInfoBrowseNodesAncestor is a constant for BrowseNodeInfo.BrowseNodes.Ancestor resource
+	ResourceBrowseNodeInfoBrowseNodesAncestor Resource = "BrowseNodeInfo.BrowseNodes.Ancestor"
+	// ResourceBrowseNodeInfoBrowseNodesSalesRank is a constant for BrowseNodeInfo.BrowseNodes.SalesRank resource
+	ResourceBrowseNodeInfoBrowseNodesSalesRank Resource = "BrowseNodeInfo.BrowseNodes.SalesRank"
+	// ResourceBrowseNodeInfoWebsiteSalesRank is a constant for BrowseNodeInfo.WebsiteSalesRank resource
+	ResourceBrowseNodeInfoWebsiteSalesRank Resource = "BrowseNodeInfo.WebsiteSalesRank"
+	// ResourceImagesPrimarySmall is a constant for Images.Primary.Small resource
+	ResourceImagesPrimarySmall Resource = "Images.Primary.Small"
+	// ResourceImagesPrimaryMedium is a constant for Images.Primary.Medium resource
+	ResourceImagesPrimaryMedium Resource = "Images.Primary.Medium"
+	// ResourceImagesPrimaryLarge is a constant for Images.Primary.Large resource
+	ResourceImagesPrimaryLarge Resource = "Images.Primary.Large"
+	// ResourceImagesVariantsSmall is a constant for Images.Variants.Small resource
+	ResourceImagesVariantsSmall Resource = "Images.Variants.Small"
+	// ResourceImagesVariantsMedium is a constant for Images.Variants.Medium resource
+	ResourceImagesVariantsMedium Resource = "Images.Variants.Medium"
+	// ResourceImagesVariantsLarge is a constant for Images.Variants.Large resource
+	ResourceImagesVariantsLarge Resource = "Images.Variants.Large"
+	// ResourceItemInfoByLineInfo is a constant for ItemInfo.ByLineInfo resource
+	ResourceItemInfoByLineInfo Resource = "ItemInfo.ByLineInfo"
+	// ResourceItemInfoClassifications is a constant for ItemInfo.Classifications resource
+	ResourceItemInfoClassifications Resource = "ItemInfo.Classifications"
+	// ResourceItemInfoContentInfo is a constant for ItemInfo.ContentInfo resource
+	ResourceItemInfoContentInfo Resource = "ItemInfo.ContentInfo"
+	// ResourceItemInfoExternalIds is a constant for ItemInfo.ExternalIds resource
+	ResourceItemInfoExternalIds Resource = "ItemInfo.ExternalIds"
+	// ResourceItemInfoFeatures is a constant for ItemInfo.Features resource
+	ResourceItemInfoFeatures Resource = "ItemInfo.Features"
+	// ResourceItemInfoProductInfo is a constant for ItemInfo.ProductInfo resource
+	ResourceItemInfoProductInfo Resource = "ItemInfo.ProductInfo"
+	// ResourceItemInfoTitle is a constant for ItemInfo.Title resource
+	ResourceItemInfoTitle Resource = "ItemInfo.Title"
+	// ResourceOffersListingsAvailabilityMessage is a constant for Offers.Listings.Availability.Message resource
+	ResourceOffersListingsAvailabilityMessage Resource = "Offers.Listings.Availability.Message"
+	// ResourceOffersListingsCondition is a constant for Offers.Listings.Condition resource
+	ResourceOffersListingsCondition Resource = "Offers.Listings.Condition"
+	// ResourceOffersListingsDeliveryInfoIsPrimeEligible is a constant for Offers.Listings.DeliveryInfo.IsPrimeEligible resource
+	ResourceOffersListingsDeliveryInfoIsPrimeEligible Resource = "Offers.Listings.DeliveryInfo.IsPrimeEligible"
+	// ResourceOffersListingsIsBuyBoxWinner is a constant for Offers.Listings.IsBuyBoxWinner resource
+	ResourceOffersListingsIsBuyBoxWinner Resource = "Offers.Listings.IsBuyBoxWinner"
+	// ResourceOffersListingsMerchantInfo is a constant for Offers.Listings.MerchantInfo resource
+	ResourceOffersListingsMerchantInfo Resource = "Offers.Listings.MerchantInfo"
+	// ResourceOffersListingsPrice is a constant for Offers.Listings.Price resource
+	ResourceOffersListingsPrice Resource = "Offers.Listings.Price"
+	// ResourceOffersSummariesHighestPrice is a constant for Offers.Summaries.HighestPrice resource
+	ResourceOffersSummariesHighestPrice Resource = "Offers.Summaries.HighestPrice"
+	// ResourceOffersSummariesLowestPrice is a constant for Offers.Summaries.LowestPrice resource
+	ResourceOffersSummariesLowestPrice Resource = "Offers.Summaries.LowestPrice"
+	// ResourceOffersSummariesOfferCount is a constant for Offers.Summaries.OfferCount resource
+	ResourceOffersSummariesOfferCount Resource = "Offers.Summaries.OfferCount"
+	// ResourceParentASIN is a constant for ParentASIN resource
+	ResourceParentASIN Resource = "ParentASIN"
+	// ResourceSearchRefinements is a constant for SearchRefinements resource of SearchItems
+	ResourceSearchRefinements Resource = "SearchRefinements"
+	// ResourceVariationSummaryPriceHighestPrice is a constant for VariationSummary.Price.HighestPrice resource of GetVariations
+	ResourceVariationSummaryPriceHighestPrice Resource = "VariationSummary.Price.HighestPrice"
+	// ResourceVariationSummaryPriceLowestPrice is a constant for VariationSummary.Price.LowestPrice resource of GetVariations
+	ResourceVariationSummaryPriceLowestPrice Resource = "VariationSummary.Price.LowestPrice"
+	// ResourceVariationSummaryVariationDimension is a constant for VariationSummary.VariationDimension resource of GetVariations
+	ResourceVariationSummaryVariationDimension Resource = "VariationSummary.VariationDimension"
+	// ResourceBrowseNodesAncestor is a constant for BrowseNodes.Ancestor resource of GetBrowseNodes
+	ResourceBrowseNodesAncestor Resource = "BrowseNodes.Ancestor"
+	// ResourceBrowseNodesChildren is a constant for BrowseNodes.Children resource of GetBrowseNodes
+	ResourceBrowseNodesChildren Resource = "BrowseNodes.Children"
+)
@@ -0,0 +1,175 @@
+package paapi5
+
+import (
+	"context"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+// SortBy represents SortBy parameter of SearchItems
+type SortBy string
+
+const (
+	// SortByAvgCustomerReviews is a constant for AvgCustomerReviews sort
+	SortByAvgCustomerReviews SortBy = "AvgCustomerReviews"
+	// SortByFeatured is a constant for Featured sort
+	SortByFeatured SortBy = "Featured"
+	// SortByNewestArrivals is a constant for NewestArrivals sort
+	SortByNewestArrivals SortBy = "NewestArrivals"
+	// SortByPriceHighToLow is a constant for Price:HighToLow sort
+	SortByPriceHighToLow SortBy = "Price:HighToLow"
+	// SortByPriceLowToHigh is a constant for Price:LowToHigh sort
+	SortByPriceLowToHigh SortBy = "Price:LowToHigh"
+	// SortByRelevance is a constant for Relevance sort
+	SortByRelevance SortBy = "Relevance"
+)
+
+const (
+	// MaxSearchItemsItemCount is maximum ItemCount of SearchItems
+	MaxSearchItemsItemCount = 10
+	// MaxSearchItemsItemPage is maximum ItemPage of SearchItems
+	MaxSearchItemsItemPage = 10
+)
+
+// SearchItemsParameters represents parameters for SearchItems operation request.
+// SearchIndex is passed as is, so amazon.SearchIndex constants whose names are same in PA-API 5.0 are usable
+type SearchItemsParameters struct {
+	Keywords              string
+	SearchIndex           amazon.SearchIndex
+	Actor                 string
+	Artist                string
+	Author                string
+	Brand                 string
+	Title                 string
+	BrowseNodeID          string
+	Availability          string
+	Condition             amazon.Condition
+	CurrencyOfPreference  string
+	LanguagesOfPreference []string
+	Merchant              string
+	// MinPrice is minimum price in the lowest currency denomination. For example, 3241 is $32.41
+	MinPrice int
+	// MaxPrice is maximum price in the lowest currency denomination
+	MaxPrice         int
+	MinReviewsRating int
+	MinSavingPercent int
+	OfferCount       int
+	ItemCount        int
+	ItemPage         int
+	SortBy           SortBy
+	Resources        []Resource
+}
+
+// SearchItemsRequest represents request for SearchItems operation
+type SearchItemsRequest struct {
+	Client     *Client
+	Parameters SearchItemsParameters
+}
+
+// SearchItemsResponse represents response for SearchItems operation
+type SearchItemsResponse struct {
+	SearchResult struct {
+		Items            []Item
+		SearchURL        string
+		TotalResultCount int
+	}
+	Errors []amazon.Error
+}
+
+// Error returns errors of the response
+func (res *SearchItemsResponse) Error() error {
+	return errorsOf(res.Errors)
+}
+
+// Items returns found items
+func (res *SearchItemsResponse) Items() []Item {
+	return res.SearchResult.Items
+}
+
+type searchItemsPayload struct {
+	partner
+	Keywords              string             `json:",omitempty"`
+	SearchIndex           amazon.SearchIndex `json:",omitempty"`
+	Actor                 string             `json:",omitempty"`
+	Artist                string             `json:",omitempty"`
+	Author                string             `json:",omitempty"`
+	Brand                 string             `json:",omitempty"`
+	Title                 string             `json:",omitempty"`
+	BrowseNodeID          string             `json:"BrowseNodeId,omitempty"`
+	Availability          string             `json:",omitempty"`
+	Condition             string             `json:",omitempty"`
+	CurrencyOfPreference  string             `json:",omitempty"`
+	LanguagesOfPreference []string           `json:",omitempty"`
+	Merchant              string             `json:",omitempty"`
+	MinPrice              int                `json:",omitempty"`
+	MaxPrice              int                `json:",omitempty"`
+	MinReviewsRating      int                `json:",omitempty"`
+	MinSavingPercent      int                `json:",omitempty"`
+	OfferCount            int                `json:",omitempty"`
+	ItemCount             int                `json:",omitempty"`
+	ItemPage              int                `json:",omitempty"`
+	SortBy                SortBy             `json:",omitempty"`
+	Resources             []Resource         `json:",omitempty"`
+}
+
+func (req *SearchItemsRequest) payload() interface{} {
+	p := req.Parameters
+	return searchItemsPayload{
+		partner:               req.Client.partner(),
+		Keywords:              p.Keywords,
+		SearchIndex:           p.SearchIndex,
+		Actor:                 p.Actor,
+		Artist:                p.Artist,
+		Author:                p.Author,
+		Brand:                 p.Brand,
+		Title:                 p.Title,
+		BrowseNodeID:          p.BrowseNodeID,
+		Availability:          p.Availability,
+		Condition:             condition(p.Condition),
+		CurrencyOfPreference:  p.CurrencyOfPreference,
+		LanguagesOfPreference: p.LanguagesOfPreference,
+		Merchant:              p.Merchant,
+		MinPrice:              p.MinPrice,
+		MaxPrice:              p.MaxPrice,
+		MinReviewsRating:      p.MinReviewsRating,
+		MinSavingPercent:      p.MinSavingPercent,
+		OfferCount:            p.OfferCount,
+		ItemCount:             p.ItemCount,
+		ItemPage:              p.ItemPage,
+		SortBy:                p.SortBy,
+		Resources:             p.Resources,
+	}
+}
+
+func (req *SearchItemsRequest) operation() string {
+	return "SearchItems"
+}
+
+func (req *SearchItemsRequest) path() string {
+	return "/paapi5/searchitems"
+}
+
+// Do sends request for the API
+func (req *SearchItemsRequest) Do() (*SearchItemsResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *SearchItemsRequest) DoContext(ctx context.Context) (*SearchItemsResponse, error) {
+	respObj := SearchItemsResponse{}
+	if _, err := req.Client.doRequest(ctx, req, &respObj); err != nil {
+		return nil, err
+	}
+	if err := respObj.Error(); err != nil {
+		return nil, err
+	}
+	return &respObj, nil
+}
+
+// SearchItems returns new request for SearchItems
+func (client *Client) SearchItems(parameters SearchItemsParameters) *SearchItemsRequest {
+	return &SearchItemsRequest{
+		Client:     client,
+		Parameters: parameters,
+	}
+}
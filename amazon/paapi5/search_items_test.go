@@ -0,0 +1,55 @@
+package paapi5
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon"
+)
+
+func TestSearchItems(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"SearchItems": {200, `{
+  "SearchResult": {
+    "Items": [
+      {"ASIN": "4621300253", "ItemInfo": {"Title": {"DisplayValue": "プログラミング言語Go"}}},
+      {"ASIN": "4873118220", "ItemInfo": {"Title": {"DisplayValue": "Go言語による並行処理"}}}
+    ],
+    "SearchURL": "https://www.amazon.co.jp/s?k=Go&i=stripbooks&tag=ngsio-22",
+    "TotalResultCount": 146
+  }
+}`}})
+	defer server.Close()
+	res, err := server.newClient(t).SearchItems(SearchItemsParameters{
+		Keywords:     "Go",
+		SearchIndex:  amazon.SearchIndexBooks,
+		BrowseNodeID: "466298",
+		MinPrice:     1000,
+		ItemCount:    2,
+		ItemPage:     3,
+		SortBy:       SortByPriceLowToHigh,
+	}).Do()
+	Test{nil, err}.Compare(t)
+	Test{"SearchItems /paapi5/searchitems", server.targets[0]}.Compare(t)
+	Test{map[string]interface{}{
+		"PartnerTag":   "ngsio-22",
+		"PartnerType":  "Associates",
+		"Marketplace":  "www.amazon.co.jp",
+		"Keywords":     "Go",
+		"SearchIndex":  "Books",
+		"BrowseNodeId": "466298",
+		"MinPrice":     1000.0,
+		"ItemCount":    2.0,
+		"ItemPage":     3.0,
+		"SortBy":       "Price:LowToHigh",
+	}, server.payloads[0]}.DeepEqual(t)
+	Test{146, res.SearchResult.TotalResultCount}.Compare(t)
+	Test{2, len(res.Items())}.Compare(t)
+	Test{"Go言語による並行処理", res.Items()[1].ItemInfo.Title.DisplayValue}.Compare(t)
+}
+
+func TestSearchItemsNoResults(t *testing.T) {
+	server := newStandInServer(map[string]standInReply{"SearchItems": {404, `{"__type":"com.amazon.paapi5#ResourceNotFoundException","Errors":[{"Code":"NoResults","Message":"No results found for your request."}]}`}})
+	defer server.Close()
+	_, err := server.newClient(t).SearchItems(SearchItemsParameters{Keywords: "nothing"}).Do()
+	Test{true, errors.Is(err, NoResults)}.Compare(t)
+}
@@ -0,0 +1,72 @@
+package amazon
+
+import "context"
+
+// pageWalker walks items of paginated responses, requesting pages lazily with fetch.
+// It is shared by iterators of operations returning items page by page
+type pageWalker struct {
+	ctx context.Context
+	// start is the first page to request, 1 if not positive
+	start int
+	// maxPage is the page limit of the operation, unlimited if not positive
+	maxPage int
+	// fetch requests the page and returns number of items in it and total number of pages
+	fetch func(ctx context.Context, page int) (items int, totalPages int, err error)
+
+	page       int
+	index      int
+	items      int
+	totalPages int
+	fetched    bool
+	err        error
+}
+
+// next advances to the next item, requesting the next page if needed.
+// It returns false when there are no more items or an error occurred
+func (w *pageWalker) next() bool {
+	if w.err != nil {
+		return false
+	}
+	if w.fetched && w.index+1 < w.items {
+		w.index++
+		return true
+	}
+	for w.hasNextPage() {
+		if err := w.ctx.Err(); err != nil {
+			w.err = err
+			return false
+		}
+		w.page = w.nextPage()
+		items, totalPages, err := w.fetch(w.ctx, w.page)
+		if err != nil {
+			w.err = err
+			return false
+		}
+		w.fetched = true
+		w.index = 0
+		w.items = items
+		w.totalPages = totalPages
+		if items > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *pageWalker) nextPage() int {
+	if w.page > 0 {
+		return w.page + 1
+	}
+	if w.start > 0 {
+		return w.start
+	}
+	return 1
+}
+
+func (w *pageWalker) hasNextPage() bool {
+	next := w.nextPage()
+	if w.maxPage > 0 && next > w.maxPage {
+		return false
+	}
+	return !w.fetched || next <= w.totalPages
+}
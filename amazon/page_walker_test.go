@@ -0,0 +1,81 @@
+package amazon
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPageWalker(t *testing.T) {
+	for _, test := range []struct {
+		start   int
+		maxPage int
+		items   []int
+		pages   []int
+		count   int
+	}{
+		{0, 0, []int{10, 10, 5}, []int{1, 2, 3}, 25},
+		{2, 0, []int{10, 10, 5}, []int{2, 3}, 15},
+		{0, 2, []int{10, 10, 5}, []int{1, 2}, 20},
+		{0, 0, []int{10, 0, 5}, []int{1, 2, 3}, 15},
+		{0, 0, []int{0}, []int{1}, 0},
+		{0, 0, []int{}, []int{1}, 0},
+	} {
+		var pages []int
+		w := pageWalker{
+			ctx:     context.Background(),
+			start:   test.start,
+			maxPage: test.maxPage,
+			fetch: func(ctx context.Context, page int) (int, int, error) {
+				pages = append(pages, page)
+				if page > len(test.items) {
+					return 0, 0, nil
+				}
+				return test.items[page-1], len(test.items), nil
+			},
+		}
+		count := 0
+		for w.next() {
+			count++
+		}
+		Test{nil, w.err}.Compare(t)
+		Test{test.pages, pages}.DeepEqual(t)
+		Test{test.count, count}.Compare(t)
+	}
+}
+
+func TestPageWalkerError(t *testing.T) {
+	fetchErr := errors.New("omg")
+	w := pageWalker{
+		ctx: context.Background(),
+		fetch: func(ctx context.Context, page int) (int, int, error) {
+			if page > 1 {
+				return 0, 0, fetchErr
+			}
+			return 2, 3, nil
+		},
+	}
+	Test{true, w.next()}.Compare(t)
+	Test{true, w.next()}.Compare(t)
+	Test{false, w.next()}.Compare(t)
+	Test{fetchErr, w.err}.Compare(t)
+	Test{false, w.next()}.Compare(t)
+	Test{2, w.page}.Compare(t)
+}
+
+func TestPageWalkerCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	requests := 0
+	w := pageWalker{
+		ctx: ctx,
+		fetch: func(ctx context.Context, page int) (int, int, error) {
+			requests++
+			cancel()
+			return 1, 3, nil
+		},
+	}
+	Test{true, w.next()}.Compare(t)
+	Test{false, w.next()}.Compare(t)
+	Test{context.Canceled, w.err}.Compare(t)
+	Test{1, requests}.Compare(t)
+}
@@ -0,0 +1,108 @@
+package amazon
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimiter allows one request per second for each AccessKeyID, as the API quota does.
+// It is shared by clients returned by New and NewWithCredentials. Set nil to create clients not limited by default
+var DefaultRateLimiter = NewRateLimiter(1, 1)
+
+// RateLimiter limits rate of requests with token bucket for each AccessKeyID.
+// It is safe for concurrent use and can be shared across multiple Client values
+type RateLimiter struct {
+	rate    float64
+	burst   int
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	now     func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns new RateLimiter that allows rate requests per second with burst
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:    rate,
+		burst:   burst,
+		buckets: map[string]*tokenBucket{},
+		now:     time.Now,
+	}
+}
+
+// WithRateLimiter sets RateLimiter instead of DefaultRateLimiter. Requests are not limited if nil
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(client *Client) error {
+		client.RateLimiter = limiter
+		return nil
+	}
+}
+
+// Rate returns allowed requests per second
+func (limiter *RateLimiter) Rate() float64 {
+	return limiter.rate
+}
+
+// Burst returns maximum number of requests sent at once
+func (limiter *RateLimiter) Burst() int {
+	return limiter.burst
+}
+
+// Wait blocks until a request for accessKeyID is allowed or the context is done
+func (limiter *RateLimiter) Wait(ctx context.Context, accessKeyID string) error {
+	delay := limiter.reserve(accessKeyID)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		limiter.cancel(accessKeyID)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (limiter *RateLimiter) reserve(accessKeyID string) time.Duration {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	now := limiter.now()
+	bucket, ok := limiter.buckets[accessKeyID]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(limiter.burst), last: now}
+		limiter.buckets[accessKeyID] = bucket
+	}
+	if limiter.rate <= 0 {
+		return 0
+	}
+	if elapsed := now.Sub(bucket.last); elapsed > 0 {
+		bucket.tokens += elapsed.Seconds() * limiter.rate
+		if bucket.tokens > float64(limiter.burst) {
+			bucket.tokens = float64(limiter.burst)
+		}
+		bucket.last = now
+	}
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / limiter.rate * float64(time.Second))
+}
+
+func (limiter *RateLimiter) cancel(accessKeyID string) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	if bucket, ok := limiter.buckets[accessKeyID]; ok {
+		bucket.tokens++
+	}
+}
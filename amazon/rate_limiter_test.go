@@ -0,0 +1,74 @@
+package amazon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ngs/go-amazon-product-advertising-api/amazon/credentials"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2016, time.November, 16, 21, 34, 0, 0, time.UTC)
+	limiter := NewRateLimiter(1, 2)
+	limiter.now = func() time.Time { return now }
+	for _, test := range []Test{
+		{time.Duration(0), limiter.reserve("AK")},
+		{time.Duration(0), limiter.reserve("AK")},
+		{time.Second, limiter.reserve("AK")},
+		{2 * time.Second, limiter.reserve("AK")},
+		{time.Duration(0), limiter.reserve("AK2")},
+	} {
+		test.Compare(t)
+	}
+	now = now.Add(10 * time.Second)
+	for _, test := range []Test{
+		{time.Duration(0), limiter.reserve("AK")},
+		{time.Duration(0), limiter.reserve("AK")},
+		{time.Second, limiter.reserve("AK")},
+	} {
+		test.Compare(t)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(0.001, 1)
+	if err := limiter.Wait(context.Background(), "AK"); err != nil {
+		t.Errorf("Expected nil but got %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	Test{context.DeadlineExceeded, limiter.Wait(ctx, "AK")}.Compare(t)
+	Test{true, limiter.buckets["AK"].tokens >= 0}.Compare(t)
+}
+
+func TestRateLimiterSharedAcrossClients(t *testing.T) {
+	limiter := NewRateLimiter(50, 1)
+	doer := &mockDoer{body: "<mock><result>OK</result></mock>"}
+	client1, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRateLimiter(limiter))
+	client2, _ := New("AK", "SK", "ngsio-22", RegionUS, WithHTTPClient(doer), WithRateLimiter(limiter))
+	start := time.Now()
+	for _, client := range []*Client{client1, client2, client1} {
+		if _, err := client.DoRequest(&mockOperation{}, &mockResponse{}); err != nil {
+			t.Errorf("Expected nil but got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Expected requests to be limited but took %v", elapsed)
+	}
+	Test{3, len(doer.requests)}.Compare(t)
+}
+
+func TestDefaultRateLimiter(t *testing.T) {
+	Test{1.0, DefaultRateLimiter.Rate()}.Compare(t)
+	Test{1, DefaultRateLimiter.Burst()}.Compare(t)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	Test{DefaultRateLimiter, client.RateLimiter}.Compare(t)
+	client, _ = NewWithCredentials(credentials.NewStatic("AK", "SK", ""), "ngsio-22", RegionJapan)
+	Test{DefaultRateLimiter, client.RateLimiter}.Compare(t)
+	limiter := NewRateLimiter(10, 1)
+	client, _ = New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(limiter))
+	Test{limiter, client.RateLimiter}.Compare(t)
+	client, _ = New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
+	Test{true, client.RateLimiter == nil}.Compare(t)
+}
@@ -1,9 +1,18 @@
 package amazon
 
+import (
+	"sort"
+	"strings"
+)
+
 // Region constants
 type Region string
 
 const (
+	// RegionAustralia Australia
+	RegionAustralia Region = "AU"
+	// RegionBelgium Belgium
+	RegionBelgium Region = "BE"
 	// RegionBrazil Brazil
 	RegionBrazil Region = "BR"
 	// RegionCanada Canada
@@ -12,6 +21,8 @@ const (
 	RegionChina Region = "CN"
 	// RegionGermany Germany
 	RegionGermany Region = "DE"
+	// RegionEgypt  Egypt
+	RegionEgypt Region = "EG"
 	// RegionSpain  Spain
 	RegionSpain Region = "ES"
 	// RegionFrance France
@@ -24,30 +35,168 @@ const (
 	RegionJapan Region = "JP"
 	// RegionMexico Mexico
 	RegionMexico Region = "MX"
+	// RegionNetherlands Netherlands
+	RegionNetherlands Region = "NL"
+	// RegionPoland Poland
+	RegionPoland Region = "PL"
+	// RegionSaudiArabia Saudi Arabia
+	RegionSaudiArabia Region = "SA"
+	// RegionSweden Sweden
+	RegionSweden Region = "SE"
+	// RegionSingapore Singapore
+	RegionSingapore Region = "SG"
+	// RegionTurkey Turkey
+	RegionTurkey Region = "TR"
+	// RegionUAE    United Arab Emirates
+	RegionUAE Region = "AE"
 	// RegionUK     UK
 	RegionUK Region = "UK"
 	// RegionUS     US
 	RegionUS Region = "US"
 )
 
-var regionEndpointMap = map[Region]string{
-	RegionBrazil:  "webservices.amazon.com.br",
-	RegionCanada:  "webservices.amazon.ca",
-	RegionChina:   "webservices.amazon.cn",
-	RegionGermany: "webservices.amazon.de",
-	RegionSpain:   "webservices.amazon.es",
-	RegionFrance:  "webservices.amazon.fr",
-	RegionIndia:   "webservices.amazon.in",
-	RegionItaly:   "webservices.amazon.it",
-	RegionJapan:   "webservices.amazon.co.jp",
-	RegionMexico:  "webservices.amazon.com.mx",
-	RegionUK:      "webservices.amazon.co.uk",
-	RegionUS:      "webservices.amazon.com",
+// regionInfo is metadata of a marketplace
+type regionInfo struct {
+	name string
+	// endpoint is host of Product Advertising API 2013-08-01, empty if the marketplace is not served by it
+	endpoint string
+	domain   string
+	currency Currency
+	locale   string
+	// paapi5Host and paapi5Region are host and AWS region of PA-API 5.0, empty if the marketplace is not served by it
+	paapi5Host   string
+	paapi5Region string
+	// searchIndexes are search indexes of Product Advertising API 2013-08-01, nil if the marketplace is not served by it
+	searchIndexes []SearchIndex
+}
+
+// europeanSearchIndexes are search indexes of DE, ES, FR, IT and UK
+var europeanSearchIndexes = []SearchIndex{
+	SearchIndexAll, SearchIndexApparel, SearchIndexAutomotive, SearchIndexBaby, SearchIndexBeauty,
+	SearchIndexBlended, SearchIndexBooks, SearchIndexClassical, SearchIndexDVD, SearchIndexElectronics,
+	SearchIndexForeignBooks, SearchIndexGiftCards, SearchIndexGrocery,
+	SearchIndexHealthPersonalCare, SearchIndexJewelry, SearchIndexKindleStore, SearchIndexKitchen,
+	SearchIndexLighting, SearchIndexLuggage, SearchIndexMP3Downloads, SearchIndexMusic,
+	SearchIndexMusicalInstruments, SearchIndexOfficeProducts, SearchIndexPCHardware, SearchIndexPetSupplies,
+	SearchIndexShoes, SearchIndexSoftware, SearchIndexSportingGoods, SearchIndexTools, SearchIndexToys,
+	SearchIndexVideoGames, SearchIndexWatches,
+}
+
+var regionInfoMap = map[Region]regionInfo{
+	RegionAustralia: {"Australia", "", "www.amazon.com.au", CurrencyAUD, "en-AU",
+		"webservices.amazon.com.au", "us-west-2", nil},
+	RegionBelgium: {"Belgium", "", "www.amazon.com.be", CurrencyEUR, "fr-BE",
+		"webservices.amazon.com.be", "eu-west-1", nil},
+	RegionBrazil: {"Brazil", "webservices.amazon.com.br", "www.amazon.com.br", CurrencyBRL, "pt-BR",
+		"webservices.amazon.com.br", "us-east-1", []SearchIndex{
+			SearchIndexAll, SearchIndexBooks, SearchIndexElectronics, SearchIndexHomeGarden,
+			SearchIndexKindleStore, SearchIndexMobileApps, SearchIndexOfficeProducts, SearchIndexToys,
+			SearchIndexVideoGames,
+		}},
+	RegionCanada: {"Canada", "webservices.amazon.ca", "www.amazon.ca", CurrencyCAD, "en-CA",
+		"webservices.amazon.ca", "us-east-1", []SearchIndex{
+			SearchIndexAll, SearchIndexApparel, SearchIndexAutomotive, SearchIndexBaby, SearchIndexBeauty,
+			SearchIndexBlended, SearchIndexBooks, SearchIndexDVD, SearchIndexElectronics, SearchIndexGiftCards,
+			SearchIndexGrocery, SearchIndexHealthPersonalCare, SearchIndexIndustrial, SearchIndexJewelry,
+			SearchIndexKindleStore, SearchIndexKitchen, SearchIndexLawnAndGarden, SearchIndexLuggage,
+			SearchIndexMobileApps, SearchIndexMusic, SearchIndexMusicalInstruments, SearchIndexOfficeProducts,
+			SearchIndexPetSupplies, SearchIndexShoes, SearchIndexSoftware, SearchIndexSportingGoods,
+			SearchIndexTools, SearchIndexToys, SearchIndexVideoGames, SearchIndexWatches,
+		}},
+	RegionChina: {"China", "webservices.amazon.cn", "www.amazon.cn", CurrencyCNY, "zh-CN",
+		"", "", []SearchIndex{
+			SearchIndexAll, SearchIndexApparel, SearchIndexAppliances, SearchIndexAutomotive, SearchIndexBaby,
+			SearchIndexBeauty, SearchIndexBooks, SearchIndexElectronics, SearchIndexGrocery,
+			SearchIndexHealthPersonalCare, SearchIndexHome, SearchIndexHomeImprovement, SearchIndexJewelry,
+			SearchIndexKindleStore, SearchIndexMobileApps, SearchIndexMusic,
+			SearchIndexMusicalInstruments, SearchIndexOfficeProducts, SearchIndexPetSupplies, SearchIndexPhoto,
+			SearchIndexShoes, SearchIndexSoftware, SearchIndexSportingGoods, SearchIndexToys, SearchIndexVideo,
+			SearchIndexVideoGames, SearchIndexWatches,
+		}},
+	RegionGermany: {"Germany", "webservices.amazon.de", "www.amazon.de", CurrencyEUR, "de-DE",
+		"webservices.amazon.de", "eu-west-1", europeanSearchIndexes},
+	RegionEgypt: {"Egypt", "", "www.amazon.eg", CurrencyEGP, "ar-EG",
+		"webservices.amazon.eg", "eu-west-1", nil},
+	RegionSpain: {"Spain", "webservices.amazon.es", "www.amazon.es", CurrencyEUR, "es-ES",
+		"webservices.amazon.es", "eu-west-1", europeanSearchIndexes},
+	RegionFrance: {"France", "webservices.amazon.fr", "www.amazon.fr", CurrencyEUR, "fr-FR",
+		"webservices.amazon.fr", "eu-west-1", europeanSearchIndexes},
+	RegionIndia: {"India", "webservices.amazon.in", "www.amazon.in", CurrencyINR, "en-IN",
+		"webservices.amazon.in", "eu-west-1", []SearchIndex{
+			SearchIndexAll, SearchIndexApparel, SearchIndexAppliances, SearchIndexAutomotive, SearchIndexBaby,
+			SearchIndexBeauty, SearchIndexBooks, SearchIndexDVD, SearchIndexElectronics,
+			SearchIndexGiftCards, SearchIndexGrocery, SearchIndexHealthPersonalCare, SearchIndexHomeGarden,
+			SearchIndexIndustrial, SearchIndexJewelry, SearchIndexKindleStore, SearchIndexLawnAndGarden,
+			SearchIndexLuggage, SearchIndexMusic, SearchIndexMusicalInstruments,
+			SearchIndexOfficeProducts, SearchIndexPantry, SearchIndexPCHardware, SearchIndexPetSupplies,
+			SearchIndexShoes, SearchIndexSoftware, SearchIndexSportingGoods, SearchIndexToys,
+			SearchIndexVideoGames, SearchIndexWatches,
+		}},
+	RegionItaly: {"Italy", "webservices.amazon.it", "www.amazon.it", CurrencyEUR, "it-IT",
+		"webservices.amazon.it", "eu-west-1", europeanSearchIndexes},
+	RegionJapan: {"Japan", "webservices.amazon.co.jp", "www.amazon.co.jp", CurrencyJPY, "ja-JP",
+		"webservices.amazon.co.jp", "us-west-2", []SearchIndex{
+			SearchIndexAll, SearchIndexApparel, SearchIndexAppliances, SearchIndexAutomotive, SearchIndexBaby,
+			SearchIndexBeauty, SearchIndexBlended, SearchIndexBooks, SearchIndexClassical, SearchIndexCreditCards,
+			SearchIndexDVD, SearchIndexElectronics, SearchIndexForeignBooks, SearchIndexGiftCards,
+			SearchIndexGrocery, SearchIndexHealthPersonalCare, SearchIndexHobbies, SearchIndexHomeImprovement,
+			SearchIndexIndustrial, SearchIndexJewelry, SearchIndexKindleStore, SearchIndexKitchen,
+			SearchIndexMP3Downloads, SearchIndexMusic, SearchIndexMusicalInstruments, SearchIndexOfficeProducts,
+			SearchIndexPantry, SearchIndexPCHardware, SearchIndexPetSupplies, SearchIndexShoes, SearchIndexSoftware,
+			SearchIndexSportingGoods, SearchIndexToys, SearchIndexVideo, SearchIndexVideoDownload,
+			SearchIndexVideoGames, SearchIndexWatches,
+		}},
+	RegionMexico: {"Mexico", "webservices.amazon.com.mx", "www.amazon.com.mx", CurrencyMXN, "es-MX",
+		"webservices.amazon.com.mx", "us-east-1", []SearchIndex{
+			SearchIndexAll, SearchIndexBaby, SearchIndexBooks, SearchIndexDVD, SearchIndexElectronics,
+			SearchIndexHealthPersonalCare, SearchIndexHomeImprovement, SearchIndexKindleStore, SearchIndexKitchen,
+			SearchIndexMusic, SearchIndexOfficeProducts, SearchIndexSoftware, SearchIndexSportingGoods,
+			SearchIndexToys, SearchIndexVideoGames, SearchIndexWatches,
+		}},
+	RegionNetherlands: {"Netherlands", "", "www.amazon.nl", CurrencyEUR, "nl-NL",
+		"webservices.amazon.nl", "eu-west-1", nil},
+	RegionPoland: {"Poland", "", "www.amazon.pl", CurrencyPLN, "pl-PL",
+		"webservices.amazon.pl", "eu-west-1", nil},
+	RegionSaudiArabia: {"Saudi Arabia", "", "www.amazon.sa", CurrencySAR, "ar-SA",
+		"webservices.amazon.sa", "eu-west-1", nil},
+	RegionSweden: {"Sweden", "", "www.amazon.se", CurrencySEK, "sv-SE",
+		"webservices.amazon.se", "eu-west-1", nil},
+	RegionSingapore: {"Singapore", "", "www.amazon.sg", CurrencySGD, "en-SG",
+		"webservices.amazon.sg", "us-west-2", nil},
+	RegionTurkey: {"Turkey", "", "www.amazon.com.tr", CurrencyTRY, "tr-TR",
+		"webservices.amazon.com.tr", "eu-west-1", nil},
+	RegionUAE: {"United Arab Emirates", "", "www.amazon.ae", CurrencyAED, "en-AE",
+		"webservices.amazon.ae", "eu-west-1", nil},
+	RegionUK: {"UK", "webservices.amazon.co.uk", "www.amazon.co.uk", CurrencyGBP, "en-GB",
+		"webservices.amazon.co.uk", "eu-west-1", europeanSearchIndexes},
+	RegionUS: {"US", "webservices.amazon.com", "www.amazon.com", CurrencyUSD, "en-US",
+		"webservices.amazon.com", "us-east-1", []SearchIndex{
+			SearchIndexAll, SearchIndexAppliances, SearchIndexArtsAndCrafts, SearchIndexAutomotive,
+			SearchIndexBaby, SearchIndexBeauty, SearchIndexBlended, SearchIndexBooks, SearchIndexCollectibles,
+			SearchIndexElectronics, SearchIndexFashion, SearchIndexFashionBaby, SearchIndexFashionBoys,
+			SearchIndexFashionGirls, SearchIndexFashionMen, SearchIndexFashionWomen, SearchIndexGiftCards,
+			SearchIndexGrocery, SearchIndexHealthPersonalCare, SearchIndexHomeGarden, SearchIndexIndustrial,
+			SearchIndexKindleStore, SearchIndexLawnAndGarden, SearchIndexLuggage, SearchIndexMagazines,
+			SearchIndexMobileApps, SearchIndexMovies, SearchIndexMP3Downloads, SearchIndexMusic,
+			SearchIndexMusicalInstruments, SearchIndexOfficeProducts, SearchIndexPantry, SearchIndexPCHardware,
+			SearchIndexPetSupplies, SearchIndexSoftware, SearchIndexSportingGoods, SearchIndexTools,
+			SearchIndexToys, SearchIndexUnboxVideo, SearchIndexVideoGames, SearchIndexWine, SearchIndexWireless,
+		}},
+}
+
+// Regions returns all known regions sorted by code
+func Regions() []Region {
+	regions := make([]Region, 0, len(regionInfoMap))
+	for region := range regionInfoMap {
+		regions = append(regions, region)
+	}
+	sort.Slice(regions, func(i, j int) bool { return regions[i] < regions[j] })
+	return regions
 }
 
 // Endpoint returns API endpoint for region
 func (region Region) Endpoint() string {
-	return regionEndpointMap[region]
+	return regionInfoMap[region].endpoint
 }
 
 // HTTPSEndpoint returns HTTPS endpoint
@@ -68,7 +217,78 @@ func (region Region) HTTPEndpoint() string {
 	return "http://" + ep + "/onca/xml"
 }
 
-// IsValid returns region is valid
+// IsValid returns region is valid, which is served by Product Advertising API 2013-08-01
 func (region Region) IsValid() bool {
 	return region.Endpoint() != ""
 }
+
+// IsKnown returns region is one of Regions, including marketplaces served only by PA-API 5.0
+func (region Region) IsKnown() bool {
+	_, ok := regionInfoMap[region]
+	return ok
+}
+
+// Name returns English name of the marketplace such as Japan
+func (region Region) Name() string {
+	return regionInfoMap[region].name
+}
+
+// Domain returns domain of the marketplace such as www.amazon.co.jp
+func (region Region) Domain() string {
+	return regionInfoMap[region].domain
+}
+
+// DetailPageURL returns URL of detail page of the ASIN in the marketplace
+func (region Region) DetailPageURL(asin string) string {
+	domain := region.Domain()
+	if domain == "" {
+		return ""
+	}
+	return "https://" + domain + "/dp/" + asin
+}
+
+// Currency returns default currency of the marketplace
+func (region Region) Currency() Currency {
+	return regionInfoMap[region].currency
+}
+
+// Locale returns default locale of the marketplace as BCP 47 language tag such as ja-JP
+func (region Region) Locale() string {
+	return regionInfoMap[region].locale
+}
+
+// Language returns language of Locale such as ja
+func (region Region) Language() string {
+	locale := region.Locale()
+	if i := strings.Index(locale, "-"); i >= 0 {
+		return locale[:i]
+	}
+	return locale
+}
+
+// SearchIndexes returns search indexes valid in the marketplace,
+// or nil for marketplaces served only by PA-API 5.0 which names search indexes differently
+func (region Region) SearchIndexes() []SearchIndex {
+	indexes := regionInfoMap[region].searchIndexes
+	return append([]SearchIndex(nil), indexes...)
+}
+
+// HasSearchIndex returns the search index is valid in the marketplace, always false if SearchIndexes is nil
+func (region Region) HasSearchIndex(index SearchIndex) bool {
+	for _, i := range regionInfoMap[region].searchIndexes {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+// PAAPI5Host returns host of PA-API 5.0 for the marketplace, or empty string if not served
+func (region Region) PAAPI5Host() string {
+	return regionInfoMap[region].paapi5Host
+}
+
+// PAAPI5Region returns AWS region used to sign PA-API 5.0 requests for the marketplace, or empty string if not served
+func (region Region) PAAPI5Region() string {
+	return regionInfoMap[region].paapi5Region
+}
@@ -60,3 +60,62 @@ func TestRegionHTTPEndpoint(t *testing.T) {
 		test.Compare(t)
 	}
 }
+
+func TestRegions(t *testing.T) {
+	regions := Regions()
+	Test{22, len(regions)}.Compare(t)
+	Test{RegionUAE, regions[0]}.Compare(t)
+	Test{RegionUS, regions[len(regions)-1]}.Compare(t)
+	for _, region := range regions {
+		Test{true, region.IsKnown()}.Compare(t)
+		if region.Domain() == "" || region.Currency() == "" || region.Locale() == "" {
+			t.Errorf("Expected metadata of %v but got %v", region, regionInfoMap[region])
+		}
+		Test{true, region.Currency().IsValid()}.Compare(t)
+		Test{region.IsValid(), len(region.SearchIndexes()) > 0}.Compare(t)
+		Test{region.IsValid(), region.HasSearchIndex(SearchIndexAll)}.Compare(t)
+	}
+	Test{false, Region("foo").IsKnown()}.Compare(t)
+}
+
+func TestRegionMetadata(t *testing.T) {
+	for _, test := range []Test{
+		{"Japan", RegionJapan.Name()},
+		{"www.amazon.co.jp", RegionJapan.Domain()},
+		{"https://www.amazon.co.jp/dp/4621300253", RegionJapan.DetailPageURL("4621300253")},
+		{CurrencyJPY, RegionJapan.Currency()},
+		{"ja-JP", RegionJapan.Locale()},
+		{"ja", RegionJapan.Language()},
+		{"webservices.amazon.co.jp", RegionJapan.PAAPI5Host()},
+		{"us-west-2", RegionJapan.PAAPI5Region()},
+		{true, RegionJapan.HasSearchIndex(SearchIndexHobbies)},
+		{false, RegionJapan.HasSearchIndex(SearchIndexWine)},
+		{true, RegionUS.HasSearchIndex(SearchIndexWine)},
+		{"en-GB", RegionUK.Locale()},
+		{CurrencyEUR, RegionGermany.Currency()},
+		{"", RegionChina.PAAPI5Host()},
+		{true, RegionChina.IsValid()},
+		{"United Arab Emirates", RegionUAE.Name()},
+		{"www.amazon.com.tr", RegionTurkey.Domain()},
+		{CurrencySEK, RegionSweden.Currency()},
+		{"webservices.amazon.com.au", RegionAustralia.PAAPI5Host()},
+		{"us-west-2", RegionSingapore.PAAPI5Region()},
+		{"", RegionNetherlands.Endpoint()},
+		{false, RegionPoland.IsValid()},
+		{true, RegionPoland.IsKnown()},
+		{false, RegionPoland.HasSearchIndex(SearchIndexBooks)},
+		{0, len(RegionSingapore.SearchIndexes())},
+		{"", Region("foo").DetailPageURL("4621300253")},
+		{"", Region("foo").Language()},
+	} {
+		test.Compare(t)
+	}
+	indexes := RegionJapan.SearchIndexes()
+	indexes[0] = SearchIndexWine
+	Test{SearchIndexAll, RegionJapan.SearchIndexes()[0]}.Compare(t)
+}
+
+func TestNewUnservedRegion(t *testing.T) {
+	_, err := New("AK", "SK", "ngsio-22", RegionAustralia)
+	Test{"Invalid Region AU", err.Error()}.Compare(t)
+}
@@ -0,0 +1,144 @@
+package amazon
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// RetryPolicy represents policy to retry throttled or failed requests.
+// CartCreate, CartAdd, CartModify and CartClear are never retried, since replaying them may change the cart twice
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first request
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. The delay doubles for each retry
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between retries
+	MaxDelay time.Duration
+	// RetryableErrorCodes are error codes in the response to be retried
+	RetryableErrorCodes []ErrorCode
+	// RetryableStatusCodes are HTTP status codes to be retried
+	RetryableStatusCodes []int
+}
+
+// NewRetryPolicy returns RetryPolicy that retries RequestThrottled, AWS.InternalError and 5xx responses
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Second,
+		MaxDelay:    30 * time.Second,
+		RetryableErrorCodes: []ErrorCode{
+			RequestThrottled,
+			InternalError,
+		},
+		RetryableStatusCodes: []int{
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetryPolicy sets RetryPolicy
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(client *Client) error {
+		client.RetryPolicy = policy
+		return nil
+	}
+}
+
+// nonIdempotentOperations are operations those are not retried automatically
+var nonIdempotentOperations = map[string]bool{
+	"CartCreate": true,
+	"CartAdd":    true,
+	"CartModify": true,
+	"CartClear":  true,
+}
+
+// IsIdempotent returns the operation request can be sent again without changing the result, such as lookups and CartGet
+func IsIdempotent(op OperationRequest) bool {
+	return !nonIdempotentOperations[op.operation()]
+}
+
+type errorCoder interface {
+	Code() ErrorCode
+}
+
+type responseErrorer interface {
+	Error() error
+}
+
+func (policy *RetryPolicy) shouldRetry(op OperationRequest, res *http.Response, err error, responseObject interface{}) bool {
+	if !IsIdempotent(op) || err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	if res != nil {
+		for _, code := range policy.RetryableStatusCodes {
+			if res.StatusCode == code {
+				return true
+			}
+		}
+	}
+	if err == nil {
+		if r, ok := responseObject.(responseErrorer); ok {
+			err = r.Error()
+		}
+	}
+	switch e := err.(type) {
+	case errorCoder:
+		return policy.isRetryableErrorCode(e.Code())
+	case *Errors:
+		for _, node := range e.ErrorNode {
+			if policy.isRetryableErrorCode(node.Code) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (policy *RetryPolicy) isRetryableErrorCode(code ErrorCode) bool {
+	for _, c := range policy.RetryableErrorCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Delay returns the delay before the retry following the attempt, with jitter applied
+func (policy *RetryPolicy) Delay(attempt int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < attempt && (policy.MaxDelay <= 0 || delay < policy.MaxDelay); i++ {
+		delay *= 2
+	}
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+func (policy *RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(policy.Delay(attempt))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func resetResponseObject(responseObject interface{}) {
+	v := reflect.ValueOf(responseObject)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	}
+}
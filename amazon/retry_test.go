@@ -0,0 +1,173 @@
+package amazon
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const throttledErrorResponse = `<?xml version="1.0"?>
+<ItemSearchErrorResponse xmlns="http://ecs.amazonaws.com/doc/2013-08-01/"><Error><Code>RequestThrottled</Code><Message>AWS Access Key ID: AK. You are submitting requests too quickly. Please retry your requests at a slower rate.</Message></Error><RequestId>a7b4b1b6-c6a4-4d7e-8a3c-1d2f2b0d5c3a</RequestId></ItemSearchErrorResponse>`
+
+type mockReply struct {
+	status int
+	body   string
+}
+
+type sequenceDoer struct {
+	replies  []mockReply
+	requests []*http.Request
+}
+
+func (doer *sequenceDoer) Do(req *http.Request) (*http.Response, error) {
+	reply := doer.replies[len(doer.requests)]
+	doer.requests = append(doer.requests, req)
+	return &http.Response{
+		StatusCode: reply.status,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(reply.body)),
+		Request:    req,
+	}, nil
+}
+
+func testRetryPolicy() *RetryPolicy {
+	policy := NewRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 2 * time.Millisecond
+	return policy
+}
+
+func TestRetryThrottled(t *testing.T) {
+	now := time.Date(2016, time.November, 16, 21, 34, 0, 0, time.UTC)
+	timeNowFunc = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	defer setNow(now)
+	doer := &sequenceDoer{replies: []mockReply{
+		{503, throttledErrorResponse},
+		{200, "<mock><result>OK</result></mock>"},
+	}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRetryPolicy(testRetryPolicy()), WithRateLimiter(nil))
+	mockResp := mockResponse{}
+	res, err := client.DoRequest(&mockOperation{}, &mockResp)
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{200, res.StatusCode}.Compare(t)
+	Test{"OK", mockResp.Result}.Compare(t)
+	Test{2, len(doer.requests)}.Compare(t)
+	q1, q2 := doer.requests[0].URL.Query(), doer.requests[1].URL.Query()
+	if q1.Get("Timestamp") == q2.Get("Timestamp") {
+		t.Errorf("Expected fresh Timestamp but got %v twice", q1.Get("Timestamp"))
+	}
+	if q1.Get("Signature") == q2.Get("Signature") {
+		t.Errorf("Expected fresh Signature but got %v twice", q1.Get("Signature"))
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{503, throttledErrorResponse},
+		{503, throttledErrorResponse},
+		{503, throttledErrorResponse},
+	}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRetryPolicy(testRetryPolicy()), WithRateLimiter(nil))
+	res, err := client.DoRequest(&mockOperation{}, &mockResponse{})
+	if res != nil {
+		t.Errorf("Expected nil but got %v", res)
+	}
+	Test{"Error RequestThrottled: AWS Access Key ID: AK. You are submitting requests too quickly. Please retry your requests at a slower rate. (a7b4b1b6-c6a4-4d7e-8a3c-1d2f2b0d5c3a)", err.Error()}.Compare(t)
+	Test{3, len(doer.requests)}.Compare(t)
+}
+
+func TestRetryErrorsInResponse(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{200, `<ItemLookupResponse><Items><Request><IsValid>True</IsValid><Errors><Error><Code>AWS.InternalError</Code><Message>oops</Message></Error></Errors></Request></Items></ItemLookupResponse>`},
+		{200, `<ItemLookupResponse><Items><Request><IsValid>True</IsValid></Request><Item><ASIN>4621300253</ASIN></Item></Items></ItemLookupResponse>`},
+	}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRetryPolicy(testRetryPolicy()), WithRateLimiter(nil))
+	res, err := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"4621300253"}}).Do()
+	if err != nil {
+		t.Fatalf("Expected nil but got %v", err)
+	}
+	Test{2, len(doer.requests)}.Compare(t)
+	Test{1, len(res.Items.Item)}.Compare(t)
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{200, `<ItemLookupResponse><Items><Request><IsValid>True</IsValid><Errors><Error><Code>AWS.InvalidParameterValue</Code><Message>oops</Message></Error></Errors></Request></Items></ItemLookupResponse>`},
+	}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRetryPolicy(testRetryPolicy()), WithRateLimiter(nil))
+	_, err := client.ItemLookup(ItemLookupParameters{ItemIDs: []string{"foo"}}).Do()
+	Test{"Error AWS.InvalidParameterValue: oops", err.Error()}.Compare(t)
+	Test{1, len(doer.requests)}.Compare(t)
+}
+
+func TestRetryWaitCanceled(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{503, throttledErrorResponse},
+	}}
+	policy := NewRetryPolicy()
+	policy.BaseDelay = time.Hour
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRetryPolicy(policy), WithRateLimiter(nil))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.DoRequestContext(ctx, &mockOperation{}, &mockResponse{})
+	Test{context.DeadlineExceeded, err}.Compare(t)
+	Test{1, len(doer.requests)}.Compare(t)
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for _, test := range []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{10, 5 * time.Second},
+	} {
+		delay := policy.Delay(test.attempt)
+		if delay < test.max/2 || delay > test.max {
+			t.Errorf("Expected delay between %v and %v but got %v", test.max/2, test.max, delay)
+		}
+	}
+}
+
+func TestRetryCartNotIdempotent(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{503, "<html>Service Unavailable</html>"},
+		{200, "<CartAddResponse></CartAddResponse>"},
+	}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRetryPolicy(testRetryPolicy()), WithRateLimiter(nil))
+	_, err := createCartAddRequest(client).Do()
+	if _, ok := err.(*HTTPError); !ok {
+		t.Errorf("Expected *HTTPError but got %v", err)
+	}
+	Test{1, len(doer.requests)}.Compare(t)
+	Test{"CartAdd", doer.requests[0].URL.Query().Get("Operation")}.Compare(t)
+	Test{false, IsIdempotent(&CartAddRequest{})}.Compare(t)
+	Test{false, IsIdempotent(&CartCreateRequest{})}.Compare(t)
+	Test{false, IsIdempotent(&CartModifyRequest{})}.Compare(t)
+	Test{false, IsIdempotent(&CartClearRequest{})}.Compare(t)
+	Test{true, IsIdempotent(&CartGetRequest{})}.Compare(t)
+	Test{true, IsIdempotent(&ItemLookupRequest{})}.Compare(t)
+}
+
+func TestRetryCartGet(t *testing.T) {
+	doer := &sequenceDoer{replies: []mockReply{
+		{503, "<html>Service Unavailable</html>"},
+		{200, "<CartGetResponse><Cart><Request><IsValid>True</IsValid></Request></Cart></CartGetResponse>"},
+	}}
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithHTTPClient(doer), WithRetryPolicy(testRetryPolicy()), WithRateLimiter(nil))
+	_, err := client.CartGet(CartGetParameters{CartID: "352-5038530-7983747", HMAC: "HMAC"}).Do()
+	Test{nil, err}.Compare(t)
+	Test{2, len(doer.requests)}.Compare(t)
+}
@@ -0,0 +1,145 @@
+// Package signer signs requests of Product Advertising API with the legacy HmacSHA256 query signature of 2013-08-01 API,
+// and AWS Signature Version 4 of PA-API 5.0 and other AWS services.
+//
+// Both schemes expose their intermediate strings to sign for debugging signature mismatches.
+//
+//	s := signer.New("AK", "SK")
+//	sig := s.SignQuery("GET", endpoint, query, time.Now())
+//	fmt.Println(sig.StringToSign)
+package signer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Signer signs requests with the credentials
+type Signer struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is token of temporary credentials, sent as X-Amz-Security-Token header by SignV4 if not empty
+	SessionToken string
+	// Region is AWS region of Signature Version 4. It is derived from host of the request if empty
+	Region string
+	// Service is service name of Signature Version 4. It is derived from host of the request if empty
+	Service string
+}
+
+// New returns new Signer with the credentials
+func New(accessKeyID string, secretAccessKey string) *Signer {
+	return &Signer{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+	}
+}
+
+const (
+	// TimestampFormat is format of Timestamp parameter of the legacy query signature
+	TimestampFormat = "2006-01-02T15:04:05Z"
+	// AmzDateFormat is format of X-Amz-Date header of Signature Version 4
+	AmzDateFormat = "20060102T150405Z"
+	// Algorithm is algorithm of Signature Version 4
+	Algorithm = "AWS4-HMAC-SHA256"
+	// ProductAdvertisingAPIService is service name of PA-API 5.0
+	ProductAdvertisingAPIService = "ProductAdvertisingAPI"
+)
+
+// QuerySignature is result of the legacy query signature
+type QuerySignature struct {
+	StringToSign string
+	Signature    string
+}
+
+// SignQuery sets Timestamp and Signature parameters of the query with the legacy HmacSHA256 query signature
+// for the request to the endpoint
+func (s *Signer) SignQuery(method string, endpoint *url.URL, q url.Values, t time.Time) *QuerySignature {
+	q.Set("Timestamp", t.UTC().Format(TimestampFormat))
+	sig := &QuerySignature{StringToSign: QueryStringToSign(method, endpoint.Host, endpoint.EscapedPath(), q)}
+	mac := hmac.New(sha256.New, []byte(s.SecretAccessKey))
+	mac.Write([]byte(sig.StringToSign))
+	sig.Signature = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	q.Set("Signature", sig.Signature)
+	return sig
+}
+
+// QueryStringToSign returns string to sign of the legacy query signature, ignoring Signature parameter in the query
+func QueryStringToSign(method, host, path string, q url.Values) string {
+	values := url.Values{}
+	for key, value := range q {
+		if key != "Signature" {
+			values[key] = value
+		}
+	}
+	return method + "\n" + host + "\n" + path + "\n" + CanonicalQueryString(values)
+}
+
+// CanonicalQueryString returns the query sorted by keys and escaped with %20 for spaces, as signed by the legacy query signature.
+// Only the first value of each key is used
+func CanonicalQueryString(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for key := range q {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		k := strings.Replace(url.QueryEscape(key), "+", "%20", -1)
+		v := strings.Replace(url.QueryEscape(q.Get(key)), "+", "%20", -1)
+		pairs[i] = k + "=" + v
+	}
+	return strings.Join(pairs, "&")
+}
+
+// DeriveRegionService returns AWS region and service name of Signature Version 4 derived from the host,
+// such as us-west-2 and ProductAdvertisingAPI for webservices.amazon.co.jp, or us-west-2 and sts for sts.us-west-2.amazonaws.com.
+// ok is false if the host is not known
+func DeriveRegionService(host string) (region, service string, ok bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+	if strings.HasPrefix(host, "webservices.amazon.") {
+		switch strings.TrimPrefix(host, "webservices.amazon.") {
+		case "com", "ca", "com.br", "com.mx":
+			return "us-east-1", ProductAdvertisingAPIService, true
+		case "co.jp", "com.au", "sg":
+			return "us-west-2", ProductAdvertisingAPIService, true
+		case "co.uk", "de", "es", "fr", "in", "it", "ae", "com.tr", "nl", "sa", "se", "pl", "eg", "com.be":
+			return "eu-west-1", ProductAdvertisingAPIService, true
+		}
+		return "", "", false
+	}
+	var labels []string
+	switch {
+	case strings.HasSuffix(host, ".amazonaws.com"):
+		labels = strings.Split(strings.TrimSuffix(host, ".amazonaws.com"), ".")
+	case strings.HasSuffix(host, ".amazonaws.com.cn"):
+		labels = strings.Split(strings.TrimSuffix(host, ".amazonaws.com.cn"), ".")
+	default:
+		return "", "", false
+	}
+	switch len(labels) {
+	case 1:
+		return "us-east-1", strings.TrimSuffix(labels[0], "-fips"), true
+	default:
+		return labels[len(labels)-1], strings.TrimSuffix(labels[len(labels)-2], "-fips"), true
+	}
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,146 @@
+package signer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// V4Signature is result of Signature Version 4 with its intermediate strings
+type V4Signature struct {
+	CanonicalRequest string
+	StringToSign     string
+	CredentialScope  string
+	SignedHeaders    string
+	Signature        string
+	Authorization    string
+}
+
+// SignV4 signs the request having the body with Signature Version 4 at t, and sets X-Amz-Date and Authorization headers.
+// All headers of the request and Host are signed, except Authorization and User-Agent
+func (s *Signer) SignV4(req *http.Request, body []byte, t time.Time) (*V4Signature, error) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	region, service := s.Region, s.Service
+	if region == "" || service == "" {
+		derivedRegion, derivedService, ok := DeriveRegionService(host)
+		if !ok {
+			return nil, fmt.Errorf("Region and Service are not derivable from host %v", host)
+		}
+		if region == "" {
+			region = derivedRegion
+		}
+		if service == "" {
+			service = derivedService
+		}
+	}
+	amzDate := t.UTC().Format(AmzDateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if s.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.SessionToken)
+	}
+	req.Header.Del("Authorization")
+
+	sig := &V4Signature{}
+	var canonicalHeaders string
+	canonicalHeaders, sig.SignedHeaders = canonicalHeadersV4(req.Header, host)
+	sig.CanonicalRequest = strings.Join([]string{
+		req.Method,
+		canonicalURIV4(req.URL),
+		canonicalQueryV4(req.URL.Query()),
+		canonicalHeaders,
+		sig.SignedHeaders,
+		hexSHA256(body),
+	}, "\n")
+	date := amzDate[:8]
+	sig.CredentialScope = date + "/" + region + "/" + service + "/aws4_request"
+	sig.StringToSign = Algorithm + "\n" + amzDate + "\n" + sig.CredentialScope + "\n" + hexSHA256([]byte(sig.CanonicalRequest))
+	key := hmacSHA256([]byte("AWS4"+s.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	sig.Signature = hex.EncodeToString(hmacSHA256(key, sig.StringToSign))
+	sig.Authorization = Algorithm + " Credential=" + s.AccessKeyID + "/" + sig.CredentialScope +
+		", SignedHeaders=" + sig.SignedHeaders + ", Signature=" + sig.Signature
+	req.Header.Set("Authorization", sig.Authorization)
+	return sig, nil
+}
+
+func canonicalURIV4(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// canonicalQueryV4 returns query sorted by escaped keys, then by escaped values of each key, escaped as RFC 3986
+func canonicalQueryV4(q url.Values) string {
+	escaped := make(map[string][]string, len(q))
+	keys := make([]string, 0, len(q))
+	for key, values := range q {
+		k := escapeV4(key)
+		if _, ok := escaped[k]; !ok {
+			keys = append(keys, k)
+		}
+		for _, value := range values {
+			escaped[k] = append(escaped[k], escapeV4(value))
+		}
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		values := escaped[k]
+		sort.Strings(values)
+		for _, v := range values {
+			pairs = append(pairs, k+"="+v)
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapeV4 escapes all characters except unreserved characters of RFC 3986
+func escapeV4(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// canonicalHeadersV4 returns canonical headers and signed headers of the header and host
+func canonicalHeadersV4(header http.Header, host string) (string, string) {
+	headers := map[string]string{"host": host}
+	for key, values := range header {
+		name := strings.ToLower(key)
+		if name == "authorization" || name == "user-agent" {
+			continue
+		}
+		trimmed := make([]string, len(values))
+		for i, value := range values {
+			trimmed[i] = strings.Join(strings.Fields(value), " ")
+		}
+		headers[name] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return b.String(), strings.Join(names, ";")
+}
@@ -0,0 +1,139 @@
+package signer
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// v4TestSuite are cases of AWS Signature Version 4 test suite,
+// signed with AKIDEXAMPLE for service in us-east-1 at 20150830T123600Z
+var v4TestSuite = []struct {
+	name         string
+	method       string
+	url          string
+	header       map[string]string
+	body         string
+	stringToSign string
+	signature    string
+}{
+	{
+		name:         "get-vanilla",
+		method:       "GET",
+		url:          "https://example.amazonaws.com/",
+		stringToSign: "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\nbb579772317eb040ac9ed261061d46c1f17a8133879d6129b6e1c25292927e63",
+		signature:    "5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+	},
+	{
+		name:         "get-vanilla-query-order-key-case",
+		method:       "GET",
+		url:          "https://example.amazonaws.com/?Param2=value2&Param1=value1",
+		stringToSign: "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n816cd5b414d056048ba4f7c5386d6e0533120fb1fcfa93762cf0fc39e2cf19e0",
+		signature:    "b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500",
+	},
+	{
+		name:         "get-vanilla-query",
+		method:       "GET",
+		url:          "https://example.amazonaws.com/?Param1=value1",
+		stringToSign: "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n1e24db194ed7d0eec2de28d7369675a243488e08526e8c1c73571282f7c517ab",
+		signature:    "a67d582fa61cc504c4bae71f336f98b97f1ea3c7a6bfe1b6e45aec72011b9aeb",
+	},
+	{
+		name:         "get-header-value-trim",
+		method:       "GET",
+		url:          "https://example.amazonaws.com/",
+		header:       map[string]string{"My-Header1": " value1", "My-Header2": ` "a   b   c"`},
+		stringToSign: "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\na726db9b0df21c14f559d0a978e563112acb1b9e05476f0a6a1c7d68f28605c7",
+		signature:    "acc3ed3afb60bb290fc8d2dd0098b9911fcaa05412b367055dee359757a9c736",
+	},
+	{
+		name:         "post-vanilla",
+		method:       "POST",
+		url:          "https://example.amazonaws.com/",
+		stringToSign: "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n553f88c9e4d10fc9e109e2aeb65f030801b70c2f6468faca261d401ae622fc87",
+		signature:    "5da7c1a2acd57cee7505fc6676e4e544621c30862966e37dddb68e92efbe5d6b",
+	},
+	{
+		name:         "post-x-www-form-urlencoded",
+		method:       "POST",
+		url:          "https://example.amazonaws.com/",
+		header:       map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+		body:         "Param1=value1",
+		stringToSign: "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n42a5e5bb34198acb3e84da4f085bb7927f2bc277ca766e6d19c73c2154021281",
+		signature:    "ff11897932ad3f4e8b18135d722051e5ac45fc38421b1da7b9d196a0fe09473a",
+	},
+}
+
+func TestSignV4TestSuite(t *testing.T) {
+	s := &Signer{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		Region:          "us-east-1",
+		Service:         "service",
+	}
+	for _, test := range v4TestSuite {
+		req, _ := http.NewRequest(test.method, test.url, strings.NewReader(test.body))
+		for key, value := range test.header {
+			req.Header.Set(key, value)
+		}
+		sig, err := s.SignV4(req, []byte(test.body), time.Date(2015, time.August, 30, 12, 36, 0, 0, time.UTC))
+		if err != nil {
+			t.Errorf("%v: Expected nil but got %v", test.name, err)
+			continue
+		}
+		Test{test.stringToSign, sig.StringToSign}.Compare(t)
+		Test{test.signature, sig.Signature}.Compare(t)
+		Test{"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=" + sig.SignedHeaders + ", Signature=" + test.signature,
+			req.Header.Get("Authorization")}.Compare(t)
+		Test{"20150830T123600Z", req.Header.Get("X-Amz-Date")}.Compare(t)
+	}
+}
+
+func TestSignV4CanonicalRequest(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/?Param2=value2&Param1=value1", nil)
+	req.Header.Set("Authorization", "stale")
+	req.Header.Set("User-Agent", "Go-http-client/1.1")
+	s := New("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY")
+	s.Service = "service"
+	sig, err := s.SignV4(req, nil, time.Date(2015, time.August, 30, 12, 36, 0, 0, time.UTC))
+	Test{nil, err}.Compare(t)
+	Test{"GET\n/\nParam1=value1&Param2=value2\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		sig.CanonicalRequest}.Compare(t)
+	Test{"20150830/us-east-1/service/aws4_request", sig.CredentialScope}.Compare(t)
+	Test{"b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500", sig.Signature}.Compare(t)
+}
+
+func TestSignV4CanonicalQuery(t *testing.T) {
+	for _, test := range []Test{
+		{"a=z&a-b=1", canonicalQueryV4(url.Values{"a-b": {"1"}, "a": {"z"}})},
+		{"a=1&a=2&a-b=0&a.b=0&ab=0", canonicalQueryV4(url.Values{"ab": {"0"}, "a.b": {"0"}, "a-b": {"0"}, "a": {"2", "1"}})},
+		{"k%20e=v%2Fw&k~=a", canonicalQueryV4(url.Values{"k~": {"a"}, "k e": {"v/w"}})},
+		{"", canonicalQueryV4(url.Values{})},
+	} {
+		test.Compare(t)
+	}
+}
+
+func TestSignV4DeriveRegionService(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://webservices.amazon.co.jp/paapi5/getitems", nil)
+	sig, err := New("AK", "SK").SignV4(req, nil, time.Date(2019, time.November, 16, 12, 34, 0, 0, time.UTC))
+	Test{nil, err}.Compare(t)
+	Test{"20191116/us-west-2/ProductAdvertisingAPI/aws4_request", sig.CredentialScope}.Compare(t)
+
+	req, _ = http.NewRequest("POST", "http://localhost:8080/paapi5/getitems", nil)
+	_, err = New("AK", "SK").SignV4(req, nil, time.Now())
+	Test{"Region and Service are not derivable from host localhost:8080", err.Error()}.Compare(t)
+}
+
+func TestSignV4SessionToken(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+	s := New("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY")
+	s.SessionToken = "TOKEN"
+	s.Service = "service"
+	sig, err := s.SignV4(req, nil, time.Date(2015, time.August, 30, 12, 36, 0, 0, time.UTC))
+	Test{nil, err}.Compare(t)
+	Test{"TOKEN", req.Header.Get("X-Amz-Security-Token")}.Compare(t)
+	Test{"host;x-amz-date;x-amz-security-token", sig.SignedHeaders}.Compare(t)
+}
@@ -1,6 +1,7 @@
 package amazon
 
 import (
+	"context"
 	"encoding/xml"
 	"strings"
 )
@@ -113,10 +114,16 @@ func (req *SimilarityLookupRequest) operation() string {
 
 // Do sends request for the API
 func (req *SimilarityLookupRequest) Do() (*SimilarityLookupResponse, error) {
+	return req.DoContext(context.Background())
+}
+
+// DoContext sends request for the API with the context
+func (req *SimilarityLookupRequest) DoContext(ctx context.Context) (*SimilarityLookupResponse, error) {
 	respObj := SimilarityLookupResponse{}
-	if _, err := req.Client.DoRequest(req, &respObj); err != nil {
+	if _, err := req.Client.DoRequestContext(ctx, req, &respObj); err != nil {
 		return nil, err
 	}
+	respObj.Items.Request.Errors.ResolveItemIDs(req.Parameters.ItemIDs)
 	if err := respObj.Error(); err != nil {
 		return nil, err
 	}
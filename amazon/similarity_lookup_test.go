@@ -70,7 +70,7 @@ func TestSimilarityLookupSignedURL(t *testing.T) {
 
 func TestSimilarityLookupDoErrorResponse(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestSimilarityLookupRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/SimilarityLookupResponseErrorItem.xml")
 	gock.New(strings.Replace(expectedSimilarityLookupSignedURL, "%2B", "%5C%2B", -1)).
@@ -86,7 +86,7 @@ func TestSimilarityLookupDoErrorResponse(t *testing.T) {
 
 func TestSimilarityLookupDoError(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestSimilarityLookupRequest(client)
 	gock.New(strings.Replace(expectedSimilarityLookupSignedURL, "%2B", "%5C%2B", -1)).
 		ReplyError(errors.New("omg"))
@@ -94,13 +94,13 @@ func TestSimilarityLookupDoError(t *testing.T) {
 	if err == nil {
 		t.Errorf("Expected not nil but got nil res: %v", res)
 	} else {
-		Test{"Get " + expectedSimilarityLookupSignedURL + ": omg", err.Error()}.Compare(t)
+		Test{"Get \"" + expectedSimilarityLookupSignedURL + "\": omg", err.Error()}.Compare(t)
 	}
 }
 
 func TestSimilarityLookupDo(t *testing.T) {
 	setNow(time.Date(2016, time.November, 16, 21, 34, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)))
-	client, _ := New("AK", "SK", "ngsio-22", RegionJapan)
+	client, _ := New("AK", "SK", "ngsio-22", RegionJapan, WithRateLimiter(nil))
 	op := createTestSimilarityLookupRequest(client)
 	fixtureIO, _ := os.Open("_fixtures/SimilarityLookup.xml")
 	gock.New(expectedSimilarityLookupSignedURL).
@@ -0,0 +1,7 @@
+module github.com/ngs/go-amazon-product-advertising-api
+
+go 1.20
+
+require gopkg.in/h2non/gock.v1 v1.1.2
+
+require github.com/h2non/parth v0.0.0-20190131123155-b4df798d6542 // indirect